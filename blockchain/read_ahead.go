@@ -0,0 +1,97 @@
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// readAheadCache keeps the blocks following the last loaded one in memory,
+// so that blocks read sequentially, e.g. to serve them to peers fast syncing
+// from this node, are loaded from the db in the background ahead of time.
+type readAheadCache struct {
+	size int
+
+	mtx     sync.Mutex
+	blocks  map[int64]*types.Block
+	from    int64 // the window is (from, from+size]
+	next    int64 // next height to fetch
+	max     int64 // height of the store
+	gen     int64 // incremented when blocks are deleted
+	filling bool  // whether a goroutine is filling the window
+}
+
+func newReadAheadCache(size int) *readAheadCache {
+	return &readAheadCache{
+		size:   size,
+		blocks: make(map[int64]*types.Block, size),
+	}
+}
+
+func (c *readAheadCache) get(height int64) *types.Block {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.blocks[height]
+}
+
+// moveTo moves the window after height, evicting the blocks outside of it,
+// and starts filling it, with load, up to maxHeight.
+func (c *readAheadCache) moveTo(height, maxHeight int64, load func(int64) *types.Block) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.from, c.next, c.max = height, height+1, maxHeight
+	for h := range c.blocks {
+		if !c.inWindow(h) {
+			delete(c.blocks, h)
+		}
+	}
+	if !c.filling {
+		c.filling = true
+		go c.fill(load)
+	}
+}
+
+func (c *readAheadCache) fill(load func(int64) *types.Block) {
+	for {
+		c.mtx.Lock()
+		height := c.next
+		if !c.inWindow(height) || height > c.max {
+			c.filling = false
+			c.mtx.Unlock()
+			return
+		}
+		c.next++
+		if _, ok := c.blocks[height]; ok {
+			c.mtx.Unlock()
+			continue
+		}
+		gen := c.gen
+		c.mtx.Unlock()
+
+		block := load(height)
+
+		c.mtx.Lock()
+		// don't cache a block deleted, or moved out of the window, meanwhile
+		if block != nil && gen == c.gen && c.inWindow(height) {
+			c.blocks[height] = block
+		}
+		c.mtx.Unlock()
+	}
+}
+
+// invalidate evicts the blocks above height, or the block at height only if
+// above is false, as they're deleted from the db.
+func (c *readAheadCache) invalidate(height int64, above bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.gen++
+	for h := range c.blocks {
+		if h == height && !above || h > height && above {
+			delete(c.blocks, h)
+		}
+	}
+}
+
+func (c *readAheadCache) inWindow(height int64) bool {
+	return height > c.from && height <= c.from+int64(c.size)
+}
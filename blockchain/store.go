@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -30,16 +31,49 @@ type BlockStore struct {
 
 	mtx    sync.RWMutex
 	height int64
+
+	readAhead  *readAheadCache // nil if disabled
+	writeBatch bool
+
+	// held for reading while writing to the db, and for writing by Backup
+	writeMtx sync.RWMutex
+}
+
+// BlockStoreOption sets an optional parameter on the BlockStore.
+type BlockStoreOption func(*BlockStore)
+
+// BlockStoreWithReadAhead makes LoadBlock prefetch the next blocks blocks
+// into memory in the background, trading memory for less I/O latency when
+// blocks are read sequentially, e.g. by peers fast syncing from this node.
+// 0 disables it.
+func BlockStoreWithReadAhead(blocks int) BlockStoreOption {
+	return func(bs *BlockStore) {
+		if blocks > 0 {
+			bs.readAhead = newReadAheadCache(blocks)
+		}
+	}
+}
+
+// BlockStoreWithWriteBatch makes SaveBlock write each block in a single
+// batch, flushed once, instead of setting its parts and commits one by one.
+func BlockStoreWithWriteBatch(enabled bool) BlockStoreOption {
+	return func(bs *BlockStore) {
+		bs.writeBatch = enabled
+	}
 }
 
 // NewBlockStore returns a new BlockStore with the given DB,
 // initialized to the last height that was committed to the DB.
-func NewBlockStore(db dbm.DB) *BlockStore {
+func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
 	bsjson := LoadBlockStoreStateJSON(db)
-	return &BlockStore{
+	bs := &BlockStore{
 		height: bsjson.Height,
 		db:     db,
 	}
+	for _, option := range options {
+		option(bs)
+	}
+	return bs
 }
 
 // Height returns the last known contiguous block height.
@@ -52,6 +86,20 @@ func (bs *BlockStore) Height() int64 {
 // LoadBlock returns the block with the given height.
 // If no block is found for that height, it returns nil.
 func (bs *BlockStore) LoadBlock(height int64) *types.Block {
+	if bs.readAhead == nil {
+		return bs.loadBlock(height)
+	}
+	block := bs.readAhead.get(height)
+	if block == nil {
+		block = bs.loadBlock(height)
+	}
+	if block != nil {
+		bs.readAhead.moveTo(height, bs.Height(), bs.loadBlock)
+	}
+	return block
+}
+
+func (bs *BlockStore) loadBlock(height int64) *types.Block {
 	var blockMeta = bs.LoadBlockMeta(height)
 	if blockMeta == nil {
 		return nil
@@ -153,29 +201,44 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	if !blockParts.IsComplete() {
 		cmn.PanicSanity(fmt.Sprintf("BlockStore can only save complete block part sets"))
 	}
+	bs.writeMtx.RLock()
+	defer bs.writeMtx.RUnlock()
+
+	var db dbm.SetDeleter = bs.db
+	var batch dbm.Batch
+	if bs.writeBatch {
+		batch = bs.db.NewBatch()
+		db = batch
+	}
 
 	// Save block meta
 	blockMeta := types.NewBlockMeta(block, blockParts)
 	metaBytes := cdc.MustMarshalBinaryBare(blockMeta)
-	bs.db.Set(calcBlockMetaKey(height), metaBytes)
+	db.Set(calcBlockMetaKey(height), metaBytes)
 
 	// Save block parts
 	for i := 0; i < blockParts.Total(); i++ {
 		part := blockParts.GetPart(i)
-		bs.saveBlockPart(height, i, part)
+		bs.saveBlockPart(db, height, i, part)
 	}
 
 	// Save block commit (duplicate and separate from the Block)
 	blockCommitBytes := cdc.MustMarshalBinaryBare(block.LastCommit)
-	bs.db.Set(calcBlockCommitKey(height-1), blockCommitBytes)
+	db.Set(calcBlockCommitKey(height-1), blockCommitBytes)
 
 	// Save seen commit (seen +2/3 precommits for block)
 	// NOTE: we can delete this at a later height
 	seenCommitBytes := cdc.MustMarshalBinaryBare(seenCommit)
-	bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
+	db.Set(calcSeenCommitKey(height), seenCommitBytes)
 
 	// Save new BlockStoreStateJSON descriptor
-	BlockStoreStateJSON{Height: height}.Save(bs.db)
+	if batch != nil {
+		// last, so that the block isn't recorded if the batch fails midway
+		batch.Set(blockStoreKey, BlockStoreStateJSON{Height: height}.mustMarshal())
+		batch.WriteSync()
+	} else {
+		BlockStoreStateJSON{Height: height}.Save(bs.db)
+	}
 
 	// Done!
 	bs.mtx.Lock()
@@ -183,15 +246,188 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	bs.mtx.Unlock()
 
 	// Flush
-	bs.db.SetSync(nil, nil)
+	if batch == nil {
+		bs.db.SetSync(nil, nil)
+	}
+}
+
+// DeleteBlock deletes the block at the given height, with its parts and
+// commits, from the underlying db, e.g. to prune the store. The latest block
+// can't be deleted, as it's needed to make the next one. Deleting a block
+// that isn't in the store is a no-op.
+func (bs *BlockStore) DeleteBlock(height int64) error {
+	if height >= bs.Height() {
+		return fmt.Errorf("BlockStore can't delete the latest block %v", bs.Height())
+	}
+	blockMeta := bs.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil
+	}
+	bs.writeMtx.RLock()
+	defer bs.writeMtx.RUnlock()
+
+	batch := bs.db.NewBatch()
+	deleteBlock(batch, blockMeta)
+	batch.WriteSync()
+	if bs.readAhead != nil {
+		bs.readAhead.invalidate(height, false)
+	}
+	return nil
+}
+
+// DeleteBlocksAfter deletes the blocks above the given height, making it the
+// latest height, e.g. to roll back the chain. It returns the number of deleted
+// blocks.
+func (bs *BlockStore) DeleteBlocksAfter(height int64) (int64, error) {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	if height < 0 || height > bs.height {
+		return 0, fmt.Errorf("BlockStore can't delete the blocks after %v, its height is %v", height, bs.height)
+	}
+	latest := bs.height
+	bs.writeMtx.RLock()
+	defer bs.writeMtx.RUnlock()
+
+	// lower the height first, so that blocks can be saved again on top of it
+	// even if deleting them fails midway
+	BlockStoreStateJSON{Height: height}.Save(bs.db)
+	bs.height = height
+
+	for h := latest; h > height; h-- {
+		blockMeta := bs.LoadBlockMeta(h)
+		if blockMeta == nil {
+			continue
+		}
+		batch := bs.db.NewBatch()
+		deleteBlock(batch, blockMeta)
+		batch.WriteSync()
+	}
+	// the commit of the new latest block came with the next one
+	bs.db.DeleteSync(calcBlockCommitKey(height))
+	if bs.readAhead != nil {
+		bs.readAhead.invalidate(height, true)
+	}
+	return latest - height, nil
 }
 
-func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
+func deleteBlock(batch dbm.Batch, blockMeta *types.BlockMeta) {
+	height := blockMeta.Header.Height
+	batch.Delete(calcBlockMetaKey(height))
+	for i := 0; i < blockMeta.BlockID.PartsHeader.Total; i++ {
+		batch.Delete(calcBlockPartKey(height, i))
+	}
+	batch.Delete(calcBlockCommitKey(height))
+	batch.Delete(calcSeenCommitKey(height))
+}
+
+// Backup makes a consistent copy of the db of the BlockStore, named
+// "blockstore.db" or like the db, in destDir without stopping the node. The
+// blocks aren't saved or deleted meanwhile. If the db supports it (see
+// dbm.Backuper), e.g. LevelDB, its files are hard linked into the copy;
+// otherwise, its keys are copied to a new LevelDB, which is thus compacted.
+func (bs *BlockStore) Backup(destDir string) error {
+	bs.writeMtx.Lock()
+	defer bs.writeMtx.Unlock()
+	if backuper, ok := bs.db.(dbm.Backuper); ok {
+		return backuper.Backup(destDir)
+	}
+
+	if cmn.FileExists(filepath.Join(destDir, "blockstore.db")) {
+		return fmt.Errorf("Backup %v already exists", filepath.Join(destDir, "blockstore.db"))
+	}
+	dest, err := dbm.NewGoLevelDB("blockstore", destDir)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+	itr := bs.db.Iterator(nil, nil)
+	defer itr.Close()
+	batch := dest.NewBatch()
+	for n := 1; itr.Valid(); itr.Next() {
+		batch.Set(itr.Key(), itr.Value())
+		if n%backupBatchSize == 0 {
+			batch.Write()
+			batch = dest.NewBatch()
+		}
+		n++
+	}
+	batch.WriteSync()
+	return nil
+}
+
+const backupBatchSize = 1000
+
+// CorruptedBlock is a block of the BlockStore which failed verification.
+type CorruptedBlock struct {
+	Height int64
+	Err    error
+}
+
+// Verify reads the blocks from startHeight to endHeight, inclusive, and checks
+// their parts against the part set headers, and their hashes against the
+// block IDs, of their metas. Unlike LoadBlock, it doesn't panic on corrupted
+// blocks, but returns them, so that the others can still be used. Pruned
+// blocks are skipped.
+func (bs *BlockStore) Verify(startHeight, endHeight int64) ([]CorruptedBlock, error) {
+	if height := bs.Height(); startHeight < 1 || startHeight > endHeight || endHeight > height {
+		return nil, fmt.Errorf("BlockStore can't verify the blocks %v to %v, its height is %v",
+			startHeight, endHeight, height)
+	}
+	var corrupted []CorruptedBlock
+	for height := startHeight; height <= endHeight; height++ {
+		if err := bs.verifyBlock(height); err != nil {
+			corrupted = append(corrupted, CorruptedBlock{Height: height, Err: err})
+		}
+	}
+	return corrupted, nil
+}
+
+func (bs *BlockStore) verifyBlock(height int64) error {
+	bz := bs.db.Get(calcBlockMetaKey(height))
+	if len(bz) == 0 {
+		return nil
+	}
+	blockMeta := new(types.BlockMeta)
+	if err := cdc.UnmarshalBinaryBare(bz, blockMeta); err != nil {
+		return cmn.ErrorWrap(err, "Error reading block meta")
+	}
+
+	partsHeader := blockMeta.BlockID.PartsHeader
+	buf := []byte{}
+	for i := 0; i < partsHeader.Total; i++ {
+		bz := bs.db.Get(calcBlockPartKey(height, i))
+		if len(bz) == 0 {
+			if len(bs.db.Get(calcBlockMetaKey(height))) == 0 {
+				return nil // pruned meanwhile
+			}
+			return fmt.Errorf("Missing block part %v", i)
+		}
+		part := new(types.Part)
+		if err := cdc.UnmarshalBinaryBare(bz, part); err != nil {
+			return cmn.ErrorWrap(err, "Error reading block part %v", i)
+		}
+		if part.Index != i || part.Proof.Verify(partsHeader.Hash, part.Hash()) != nil {
+			return fmt.Errorf("Block part %v doesn't match the part set header %v", i, partsHeader)
+		}
+		buf = append(buf, part.Bytes...)
+	}
+
+	block := new(types.Block)
+	if err := cdc.UnmarshalBinaryLengthPrefixed(buf, block); err != nil {
+		return cmn.ErrorWrap(err, "Error reading block")
+	}
+	if !block.HashesTo(blockMeta.BlockID.Hash) {
+		return fmt.Errorf("Block hash %X doesn't match the block ID %v", block.Hash(), blockMeta.BlockID)
+	}
+	return nil
+}
+
+func (bs *BlockStore) saveBlockPart(db dbm.SetDeleter, height int64, index int, part *types.Part) {
 	if height != bs.Height()+1 {
 		cmn.PanicSanity(fmt.Sprintf("BlockStore can only save contiguous blocks. Wanted %v, got %v", bs.Height()+1, height))
 	}
 	partBytes := cdc.MustMarshalBinaryBare(part)
-	bs.db.Set(calcBlockPartKey(height, index), partBytes)
+	db.Set(calcBlockPartKey(height, index), partBytes)
 }
 
 //-----------------------------------------------------------------------------
@@ -222,11 +458,15 @@ type BlockStoreStateJSON struct {
 
 // Save persists the blockStore state to the database as JSON.
 func (bsj BlockStoreStateJSON) Save(db dbm.DB) {
+	db.SetSync(blockStoreKey, bsj.mustMarshal())
+}
+
+func (bsj BlockStoreStateJSON) mustMarshal() []byte {
 	bytes, err := cdc.MarshalJSON(bsj)
 	if err != nil {
 		cmn.PanicSanity(fmt.Sprintf("Could not marshal state bytes: %v", err))
 	}
-	db.SetSync(blockStoreKey, bytes)
+	return bytes
 }
 
 // LoadBlockStoreStateJSON returns the BlockStoreStateJSON as loaded from disk.
@@ -3,9 +3,12 @@ package blockchain
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"runtime/debug"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -370,6 +373,253 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestDeleteBlock(t *testing.T) {
+	state, bs := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	for h := int64(1); h <= 3; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		seenCommit := &types.Commit{Precommits: []*types.Vote{{Height: h,
+			Timestamp: tmtime.Now()}}}
+		bs.SaveBlock(block, block.MakePartSet(2), seenCommit)
+	}
+
+	require.Error(t, bs.DeleteBlock(3), "can't delete the latest block")
+	require.NoError(t, bs.DeleteBlock(2))
+	assert.Nil(t, bs.LoadBlockMeta(2))
+	assert.Nil(t, bs.LoadBlock(2))
+	assert.Nil(t, bs.LoadBlockPart(2, 0))
+	assert.Nil(t, bs.LoadBlockCommit(2))
+	assert.Nil(t, bs.LoadSeenCommit(2))
+	assert.NoError(t, bs.DeleteBlock(2), "deleting a missing block is a no-op")
+
+	assert.NotNil(t, bs.LoadBlock(1))
+	assert.NotNil(t, bs.LoadBlock(3))
+	assert.EqualValues(t, 3, bs.Height())
+}
+
+func TestDeleteBlocksAfter(t *testing.T) {
+	state, bs := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	saveBlock := func(h int64) {
+		block := makeBlock(h, state, new(types.Commit))
+		seenCommit := &types.Commit{Precommits: []*types.Vote{{Height: h,
+			Timestamp: tmtime.Now()}}}
+		bs.SaveBlock(block, block.MakePartSet(2), seenCommit)
+	}
+	for h := int64(1); h <= 4; h++ {
+		saveBlock(h)
+	}
+
+	_, err := bs.DeleteBlocksAfter(5)
+	require.Error(t, err)
+	deleted, err := bs.DeleteBlocksAfter(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, deleted)
+	assert.EqualValues(t, 2, bs.Height())
+	assert.EqualValues(t, 2, LoadBlockStoreStateJSON(bs.db).Height)
+	assert.NotNil(t, bs.LoadBlock(2))
+	assert.NotNil(t, bs.LoadSeenCommit(2))
+	assert.Nil(t, bs.LoadBlockCommit(2))
+	assert.Nil(t, bs.LoadBlockMeta(3))
+	assert.Nil(t, bs.LoadBlock(4))
+
+	// the chain goes on
+	saveBlock(3)
+	assert.EqualValues(t, 3, bs.Height())
+}
+
+func TestBlockStoreReadAhead(t *testing.T) {
+	bs := NewBlockStore(db.NewMemDB(), BlockStoreWithReadAhead(3))
+	for h := int64(1); h <= 6; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		seenCommit := &types.Commit{Precommits: []*types.Vote{{Height: h,
+			Timestamp: tmtime.Now()}}}
+		bs.SaveBlock(block, block.MakePartSet(2), seenCommit)
+	}
+
+	// waitCached waits for the blocks in the window after height to be cached
+	waitCached := func(from, to int64) {
+		for i := 0; i < 100; i++ {
+			cached := true
+			for h := from; h <= to; h++ {
+				cached = cached && bs.readAhead.get(h) != nil
+			}
+			if cached {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("blocks %d to %d weren't read ahead", from, to)
+	}
+
+	require.NotNil(t, bs.LoadBlock(1))
+	waitCached(2, 4)
+	assert.Nil(t, bs.readAhead.get(5), "beyond the window")
+
+	// the next blocks are served from the cache, and the window moves on
+	cached := bs.readAhead.get(2)
+	assert.True(t, cached == bs.LoadBlock(2))
+	waitCached(3, 5)
+	assert.Nil(t, bs.readAhead.get(2), "before the window")
+
+	// the window stops at the latest block
+	require.NotNil(t, bs.LoadBlock(4))
+	waitCached(5, 6)
+	assert.Nil(t, bs.readAhead.get(7))
+
+	// deleted blocks are evicted
+	require.NoError(t, bs.DeleteBlock(5))
+	assert.Nil(t, bs.readAhead.get(5))
+	assert.Nil(t, bs.LoadBlock(5))
+	_, err := bs.DeleteBlocksAfter(4)
+	require.NoError(t, err)
+	assert.Nil(t, bs.readAhead.get(6))
+	assert.Nil(t, bs.LoadBlock(6))
+}
+
+func TestBlockStoreBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blockstore_backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the MemDB doesn't support backups, so its keys are copied
+	bs, _ := freshBlockStore()
+	for h := int64(1); h <= 3; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		seenCommit := &types.Commit{Precommits: []*types.Vote{{Height: h,
+			Timestamp: tmtime.Now()}}}
+		bs.SaveBlock(block, block.MakePartSet(2), seenCommit)
+	}
+	require.NoError(t, bs.Backup(dir))
+	require.Error(t, bs.Backup(dir), "the backup exists")
+
+	backupDB, err := dbm.NewGoLevelDB("blockstore", dir)
+	require.NoError(t, err)
+	defer backupDB.Close()
+	backup := NewBlockStore(backupDB)
+	assert.EqualValues(t, 3, backup.Height())
+	for h := int64(1); h <= 3; h++ {
+		assert.Equal(t, bs.LoadBlock(h).Hash(), backup.LoadBlock(h).Hash())
+		assert.Equal(t, bs.LoadSeenCommit(h), backup.LoadSeenCommit(h))
+	}
+	corrupted, err := backup.Verify(1, 3)
+	require.NoError(t, err)
+	assert.Empty(t, corrupted)
+}
+
+func TestBlockStoreVerify(t *testing.T) {
+	bs, db := freshBlockStore()
+	for h := int64(1); h <= 5; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		seenCommit := &types.Commit{Precommits: []*types.Vote{{Height: h,
+			Timestamp: tmtime.Now()}}}
+		bs.SaveBlock(block, block.MakePartSet(2), seenCommit)
+	}
+
+	corrupted, err := bs.Verify(1, 5)
+	require.NoError(t, err)
+	assert.Empty(t, corrupted)
+	_, err = bs.Verify(0, 5)
+	assert.Error(t, err)
+	_, err = bs.Verify(3, 2)
+	assert.Error(t, err)
+	_, err = bs.Verify(1, 6)
+	assert.Error(t, err)
+
+	// garbage part at height 2, tampered part at height 3, missing part at
+	// height 4, and pruned block at height 1
+	db.Set(calcBlockPartKey(2, 0), []byte("garbage"))
+	part := bs.LoadBlockPart(3, 1)
+	part.Bytes = append([]byte{}, part.Bytes...)
+	part.Bytes[0]++
+	db.Set(calcBlockPartKey(3, 1), cdc.MustMarshalBinaryBare(part))
+	db.Delete(calcBlockPartKey(4, 0))
+	require.NoError(t, bs.DeleteBlock(1))
+
+	corrupted, err = bs.Verify(1, 5)
+	require.NoError(t, err)
+	heights := make([]int64, len(corrupted))
+	for i, block := range corrupted {
+		heights[i] = block.Height
+		assert.Error(t, block.Err)
+	}
+	assert.Equal(t, []int64{2, 3, 4}, heights)
+}
+
+// failingBatchDB fails writing its batches after failAfter operations.
+type failingBatchDB struct {
+	dbm.DB
+	failAfter int
+}
+
+func (db *failingBatchDB) NewBatch() dbm.Batch {
+	return &failingBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+type failingBatch struct {
+	dbm.Batch
+	db  *failingBatchDB
+	ops int
+}
+
+func (b *failingBatch) Set(key, value []byte) {
+	b.count()
+	b.Batch.Set(key, value)
+}
+
+func (b *failingBatch) Delete(key []byte) {
+	b.count()
+	b.Batch.Delete(key)
+}
+
+// count writes what's in the batch, and fails, after failAfter operations,
+// like a batch applied partially by a non-atomic backend.
+func (b *failingBatch) count() {
+	if b.db.failAfter >= 0 && b.ops == b.db.failAfter {
+		b.Batch.Write()
+		panic("simulated write failure")
+	}
+	b.ops++
+}
+
+func TestBlockStoreWriteBatch(t *testing.T) {
+	db := &failingBatchDB{DB: dbm.NewMemDB(), failAfter: -1}
+	bs := NewBlockStore(db, BlockStoreWithWriteBatch(true))
+	blocks := make([]*types.Block, 4)
+	saveBlock := func(h int64) {
+		blocks[h] = makeBlock(h, state, new(types.Commit))
+		seenCommit := &types.Commit{Precommits: []*types.Vote{{Height: h,
+			Timestamp: tmtime.Now()}}}
+		bs.SaveBlock(blocks[h], blocks[h].MakePartSet(2), seenCommit)
+	}
+	saveBlock(1)
+	assert.EqualValues(t, 1, bs.Height())
+
+	// fail at every operation of the batch of the next block
+	for failAfter := 0; ; failAfter++ {
+		db.failAfter = failAfter
+		_, _, panicErr := doFn(func() (interface{}, error) {
+			saveBlock(2)
+			return nil, nil
+		})
+		if panicErr == nil {
+			break
+		}
+		assert.EqualValues(t, 1, bs.Height(), "failAfter %d", failAfter)
+		assert.EqualValues(t, 1, NewBlockStore(db).Height(), "failAfter %d", failAfter)
+		// what was written of the block is overwritten when saving it again
+		db.failAfter = -1
+		bs = NewBlockStore(db, BlockStoreWithWriteBatch(true))
+	}
+	assert.EqualValues(t, 2, bs.Height())
+
+	saveBlock(3)
+	bs = NewBlockStore(db)
+	assert.EqualValues(t, 3, bs.Height())
+	for h := int64(1); h <= 3; h++ {
+		assert.Equal(t, blocks[h].Hash(), bs.LoadBlock(h).Hash())
+		assert.NotNil(t, bs.LoadSeenCommit(h))
+	}
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	bc "github.com/tendermint/tendermint/blockchain"
+	"github.com/tendermint/tendermint/consensus"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	sm "github.com/tendermint/tendermint/state"
+)
+
+// RollbackCmd rolls the blockchain back to a previous height.
+var RollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "(unsafe) Roll the blockchain back to a previous height",
+	Long: `Roll the blockchain back to a previous height, e.g. to recover from a
+consensus bug: delete the later blocks, reset the state to the height, and
+remove the later heights from the consensus WAL, along with its saved round
+state. The node must be stopped.
+
+The application state isn't rolled back: the application must be reset to
+the height (or lower, for the blocks up to it to be replayed) separately.
+Neither is the private validator, so that it doesn't sign again at heights
+it already signed at.`,
+	RunE: rollback,
+}
+
+var (
+	rollbackHeight int64
+	rollbackDryRun bool
+)
+
+func init() {
+	RollbackCmd.Flags().Int64Var(&rollbackHeight, "height", 0, "Height to roll back to")
+	RollbackCmd.Flags().BoolVar(&rollbackDryRun, "dry-run", false, "Report what would be deleted, without deleting it")
+}
+
+func rollback(cmd *cobra.Command, args []string) error {
+	blockStoreDB := dbm.NewDB("blockstore", dbm.DBBackendType(config.DBBackend), config.DBDir())
+	defer blockStoreDB.Close()
+	stateDB := dbm.NewDB("state", dbm.DBBackendType(config.DBBackend), config.DBDir())
+	defer stateDB.Close()
+
+	blockStore := bc.NewBlockStore(blockStoreDB)
+	state := sm.LoadState(stateDB)
+	if rollbackHeight < 1 || rollbackHeight >= state.LastBlockHeight {
+		return fmt.Errorf("--height must be in [1, %d)", state.LastBlockHeight)
+	}
+	if blockStore.LoadBlockMeta(rollbackHeight) == nil || blockStore.LoadBlockMeta(rollbackHeight+1) == nil {
+		return errors.New("The block at --height, or the next one, has been pruned")
+	}
+
+	// the saved round state may hold a block of a later height
+	roundStateFile := consensus.RoundStateFile(config.Consensus.WalFile())
+
+	if rollbackDryRun {
+		walBytes, err := consensus.TruncateWAL(config.Consensus.WalFile(), rollbackHeight, true)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Would delete %d blocks, the state of %d heights, and %d bytes of the WAL\n",
+			blockStore.Height()-rollbackHeight, state.LastBlockHeight-rollbackHeight, walBytes)
+		if _, err := os.Stat(roundStateFile); err == nil {
+			fmt.Printf("Would delete the saved round state %s\n", roundStateFile)
+		}
+		return nil
+	}
+
+	// the state is rebuilt from the blocks, so reset it first
+	if _, err := sm.RollbackState(stateDB, blockStore, rollbackHeight); err != nil {
+		return err
+	}
+	blocks, err := blockStore.DeleteBlocksAfter(rollbackHeight)
+	if err != nil {
+		return err
+	}
+	walBytes, err := consensus.TruncateWAL(config.Consensus.WalFile(), rollbackHeight, false)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(roundStateFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	logger.Info("Rolled back", "height", rollbackHeight, "deletedBlocks", blocks, "deletedWALBytes", walBytes)
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+	wal-replay replays the consensus WAL of a stopped node, height by height,
+	through a headless consensus state and logs every message and state
+	transition. The node's databases aren't modified.
+
+	Usage:
+			wal-replay [-home <dir>] [-start <height>] [-end <height>] [-json] [<wal-file>...]
+
+	The WAL files default to the node's one. Rotated files (wal.000, wal.001,
+	...) must be given in order, before the head file.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	bc "github.com/tendermint/tendermint/blockchain"
+	cfg "github.com/tendermint/tendermint/config"
+	cs "github.com/tendermint/tendermint/consensus"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+func main() {
+	var (
+		home        = flag.String("home", os.ExpandEnv(filepath.Join("$HOME", cfg.DefaultTendermintDir)), "Node home directory")
+		startHeight = flag.Int64("start", 1, "First height to replay")
+		endHeight   = flag.Int64("end", 0, "Last height to replay (0 for all)")
+		jsonLog     = flag.Bool("json", false, "Log in JSON")
+	)
+	flag.Parse()
+
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	if *jsonLog {
+		logger = log.NewTMJSONLogger(log.NewSyncWriter(os.Stdout))
+	}
+
+	if err := replay(*home, flag.Args(), *startHeight, *endHeight, logger); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func replay(home string, walFiles []string, startHeight, endHeight int64, logger log.Logger) error {
+	config, err := loadConfig(home)
+	if err != nil {
+		return err
+	}
+	if len(walFiles) == 0 {
+		walFiles = []string{config.Consensus.WalFile()}
+	}
+
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return fmt.Errorf("failed to read genesis file: %v", err)
+	}
+	dbType := dbm.DBBackendType(config.DBBackend)
+	stateDB := dbm.NewDB("state", dbType, config.DBDir())
+	defer stateDB.Close()
+	blockStoreDB := dbm.NewDB("blockstore", dbType, config.DBDir())
+	defer blockStoreDB.Close()
+
+	readers := make([]io.Reader, len(walFiles))
+	for i, file := range walFiles {
+		f, err := os.Open(file)
+		if err != nil {
+			return fmt.Errorf("failed to open WAL file: %v", err)
+		}
+		defer f.Close() // nolint: errcheck
+		readers[i] = f
+	}
+
+	wr := cs.NewWALReplayer(config.Consensus, stateDB, bc.NewBlockStore(blockStoreDB), genDoc, logger)
+	return wr.Replay(io.MultiReader(readers...), startHeight, endHeight)
+}
+
+// loadConfig reads the config file of the node, if any.
+func loadConfig(home string) (*cfg.Config, error) {
+	config := cfg.DefaultConfig()
+	viper.SetConfigFile(filepath.Join(home, "config", "config.toml"))
+	if err := viper.ReadInConfig(); err == nil {
+		if err := viper.Unmarshal(config); err != nil {
+			return nil, err
+		}
+	}
+	config.SetRoot(home)
+	return config, nil
+}
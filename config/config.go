@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"time"
@@ -35,15 +36,19 @@ var (
 	defaultConfigFileName  = "config.toml"
 	defaultGenesisJSONName = "genesis.json"
 
-	defaultPrivValName  = "priv_validator.json"
-	defaultNodeKeyName  = "node_key.json"
-	defaultAddrBookName = "addrbook.json"
+	defaultPrivValName   = "priv_validator.json"
+	defaultNodeKeyName   = "node_key.json"
+	defaultAddrBookName  = "addrbook.json"
+	defaultPeerScoreName = "peer_score.json"
+	defaultBlacklistName = "peer_blacklist.json"
 
 	defaultConfigFilePath  = filepath.Join(defaultConfigDir, defaultConfigFileName)
 	defaultGenesisJSONPath = filepath.Join(defaultConfigDir, defaultGenesisJSONName)
 	defaultPrivValPath     = filepath.Join(defaultConfigDir, defaultPrivValName)
 	defaultNodeKeyPath     = filepath.Join(defaultConfigDir, defaultNodeKeyName)
 	defaultAddrBookPath    = filepath.Join(defaultConfigDir, defaultAddrBookName)
+	defaultPeerScorePath   = filepath.Join(defaultConfigDir, defaultPeerScoreName)
+	defaultBlacklistPath   = filepath.Join(defaultConfigDir, defaultBlacklistName)
 )
 
 // Config defines the top level configuration for a Tendermint node
@@ -57,6 +62,8 @@ type Config struct {
 	Mempool         *MempoolConfig         `mapstructure:"mempool"`
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
+	Store           *StoreConfig           `mapstructure:"store"`
+	Evidence        *EvidenceConfig        `mapstructure:"evidence"`
 	Instrumentation *InstrumentationConfig `mapstructure:"instrumentation"`
 }
 
@@ -69,6 +76,8 @@ func DefaultConfig() *Config {
 		Mempool:         DefaultMempoolConfig(),
 		Consensus:       DefaultConsensusConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
+		Store:           DefaultStoreConfig(),
+		Evidence:        DefaultEvidenceConfig(),
 		Instrumentation: DefaultInstrumentationConfig(),
 	}
 }
@@ -82,6 +91,8 @@ func TestConfig() *Config {
 		Mempool:         TestMempoolConfig(),
 		Consensus:       TestConsensusConfig(),
 		TxIndex:         TestTxIndexConfig(),
+		Store:           TestStoreConfig(),
+		Evidence:        TestEvidenceConfig(),
 		Instrumentation: TestInstrumentationConfig(),
 	}
 }
@@ -114,6 +125,12 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [consensus] section")
 	}
+	if err := cfg.Store.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [store] section")
+	}
+	if err := cfg.Evidence.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [evidence] section")
+	}
 	return errors.Wrap(
 		cfg.Instrumentation.ValidateBasic(),
 		"Error in [instrumentation] section",
@@ -299,8 +316,52 @@ type RPCConfig struct {
 	// Should be < {ulimit -Sn} - {MaxNumInboundPeers} - {MaxNumOutboundPeers} - {N of wal, db and other open files}
 	// 1024 - 40 - 10 - 50 = 924 = ~900
 	MaxOpenConnections int `mapstructure:"max_open_connections"`
+
+	// Maximum number of requests per second from a single IP (including
+	// WebSocket handshakes, but not the requests sent over a WebSocket).
+	// Requests above the limit receive HTTP 429 with a Retry-After header.
+	// gRPC calls (grpc_laddr) above it fail with RESOURCE_EXHAUSTED.
+	// 0 - unlimited.
+	MaxRequestsPerSecond int `mapstructure:"max_requests_per_second"`
+
+	// Maximum number of requests a single IP can send at once, on top of
+	// max_requests_per_second.
+	// 0 - same as max_requests_per_second.
+	MaxRequestsBurst int `mapstructure:"max_requests_burst"`
+
+	// IPs and CIDR blocks (e.g. "10.0.0.0/8") not subject to
+	// max_requests_per_second.
+	RateLimitWhitelist []string `mapstructure:"rate_limit_whitelist"`
+
+	// Secret used to sign the tokens authenticating RPC requests (see the
+	// rpc_token command), at least 32 characters long. If set, requests must
+	// include an "Authorization: Bearer <token>" header, and gRPC calls the
+	// same "authorization" metadata.
+	// Empty - no authentication.
+	JWTSecret string `mapstructure:"jwt_secret"`
+
+	// Endpoints not requiring authentication when jwt_secret is set
+	// (e.g. ["status", "block"]).
+	PublicEndpoints []string `mapstructure:"public_endpoints"`
+
+	// Maximum number of subscriptions a single WebSocket client can make.
+	// 0 - unlimited.
+	MaxSubscriptionsPerClient int `mapstructure:"max_subscriptions_per_client"`
+
+	// Number of /block and /block_results results of committed blocks, which
+	// never change, to keep in memory for repeated queries.
+	// 0 - no caching.
+	ResultCacheSize int `mapstructure:"result_cache_size"`
+
+	// The endpoints are served under /v1/ (e.g. /v1/status), and at the root
+	// (e.g. /status) until the next major version. If true, the responses
+	// for the unversioned paths include a Deprecation header.
+	DeprecateLegacyPaths bool `mapstructure:"deprecate_legacy_paths"`
 }
 
+// minJWTSecretLength is the minimum length of RPCConfig.JWTSecret.
+const minJWTSecretLength = 32
+
 // DefaultRPCConfig returns a default configuration for the RPC server
 func DefaultRPCConfig() *RPCConfig {
 	return &RPCConfig{
@@ -313,6 +374,17 @@ func DefaultRPCConfig() *RPCConfig {
 
 		Unsafe:             false,
 		MaxOpenConnections: 900,
+
+		MaxRequestsPerSecond: 0,
+		MaxRequestsBurst:     0,
+		RateLimitWhitelist:   []string{},
+
+		JWTSecret:       "",
+		PublicEndpoints: []string{},
+
+		MaxSubscriptionsPerClient: 5,
+		ResultCacheSize:           0,
+		DeprecateLegacyPaths:      false,
 	}
 }
 
@@ -334,6 +406,26 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return errors.New("max_open_connections can't be negative")
 	}
+	if cfg.MaxRequestsPerSecond < 0 {
+		return errors.New("max_requests_per_second can't be negative")
+	}
+	if cfg.MaxRequestsBurst < 0 {
+		return errors.New("max_requests_burst can't be negative")
+	}
+	for _, addr := range cfg.RateLimitWhitelist {
+		if _, _, err := net.ParseCIDR(addr); err != nil && net.ParseIP(addr) == nil {
+			return fmt.Errorf("rate_limit_whitelist: %q is neither an IP nor a CIDR block", addr)
+		}
+	}
+	if cfg.JWTSecret != "" && len(cfg.JWTSecret) < minJWTSecretLength {
+		return fmt.Errorf("jwt_secret must be at least %d characters long", minJWTSecretLength)
+	}
+	if cfg.MaxSubscriptionsPerClient < 0 {
+		return errors.New("max_subscriptions_per_client can't be negative")
+	}
+	if cfg.ResultCacheSize < 0 {
+		return errors.New("result_cache_size can't be negative")
+	}
 	return nil
 }
 
@@ -363,6 +455,8 @@ type P2PConfig struct {
 	PersistentPeers string `mapstructure:"persistent_peers"`
 
 	// UPNP port forwarding
+	// Opens the P2P port on the router with UPnP or NAT-PMP and advertises the
+	// router's external address to peers instead of ExternalAddress
 	UPNP bool `mapstructure:"upnp"`
 
 	// Path to address book
@@ -372,6 +466,12 @@ type P2PConfig struct {
 	// Set false for private or local networks
 	AddrBookStrict bool `mapstructure:"addr_book_strict"`
 
+	// Path to the file where peer scores are persisted across restarts
+	PeerScore string `mapstructure:"peer_score_file"`
+
+	// Path to the file where blacklisted peers are persisted across restarts
+	PeerBlacklist string `mapstructure:"peer_blacklist_file"`
+
 	// Maximum number of inbound peers
 	MaxNumInboundPeers int `mapstructure:"max_num_inbound_peers"`
 
@@ -390,6 +490,37 @@ type P2PConfig struct {
 	// Rate at which packets can be received, in bytes/second
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// Maximum rate of inbound messages per peer, in messages/second.
+	// Messages above the rate are dropped and the peer's score is decremented.
+	// 0 disables the limit.
+	RecvMessageRate float64 `mapstructure:"recv_message_rate"`
+
+	// Maximum number of inbound messages per peer allowed in a single burst
+	RecvMessageBurst int `mapstructure:"recv_message_burst"`
+
+	// Messages larger than this many bytes are gzip compressed when sent to
+	// peers which support compression. 0 disables compression.
+	CompressThreshold int `mapstructure:"compress_threshold"`
+
+	// Bounds of the adaptive ping interval. Peers with a stable latency are
+	// pinged every max_ping_interval, peers with a jittery one up to every
+	// min_ping_interval, which must be positive. A max_ping_interval of 0 keeps
+	// the fixed 60s interval.
+	MinPingInterval time.Duration `mapstructure:"min_ping_interval"`
+	MaxPingInterval time.Duration `mapstructure:"max_ping_interval"`
+
+	// Drop transactions already received from another peer, as tracked by a
+	// rolling bloom filter. dedup_capacity is the number of messages remembered
+	// per dedup_rotate_interval at the given false positive rate; 0 disables
+	// deduplication. Votes are not deduplicated here, as a false positive would
+	// lose a vote for good; see vote_dedup_capacity instead.
+	DedupCapacity       int           `mapstructure:"dedup_capacity"`
+	DedupFalsePositive  float64       `mapstructure:"dedup_false_positive_rate"`
+	DedupRotateInterval time.Duration `mapstructure:"dedup_rotate_interval"`
+
+	// Decrement the score of peers sending duplicate messages
+	DedupPenalize bool `mapstructure:"dedup_penalize"`
+
 	// Set true to enable the peer-exchange reactor
 	PexReactor bool `mapstructure:"pex"`
 
@@ -407,6 +538,8 @@ type P2PConfig struct {
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
 	// Peer connection configuration.
+	// Transport protocol for peer connections: "tcp" or "quic"
+	Protocol         string        `mapstructure:"protocol"`
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
 
@@ -426,15 +559,27 @@ func DefaultP2PConfig() *P2PConfig {
 		UPNP:                    false,
 		AddrBook:                defaultAddrBookPath,
 		AddrBookStrict:          true,
+		PeerScore:               defaultPeerScorePath,
+		PeerBlacklist:           defaultBlacklistPath,
 		MaxNumInboundPeers:      40,
 		MaxNumOutboundPeers:     10,
 		FlushThrottleTimeout:    100 * time.Millisecond,
 		MaxPacketMsgPayloadSize: 1024,    // 1 kB
 		SendRate:                5120000, // 5 mB/s
 		RecvRate:                5120000, // 5 mB/s
+		RecvMessageRate:         0,       // disabled
+		RecvMessageBurst:        100,
+		CompressThreshold:       0,
+		MinPingInterval:         0,
+		MaxPingInterval:         0,
+		DedupCapacity:           0, // disabled
+		DedupFalsePositive:      0.001,
+		DedupRotateInterval:     10 * time.Second,
+		DedupPenalize:           false,
 		PexReactor:              true,
 		SeedMode:                false,
 		AllowDuplicateIP:        true, // so non-breaking yet
+		Protocol:                "tcp",
 		HandshakeTimeout:        20 * time.Second,
 		DialTimeout:             3 * time.Second,
 		TestDialFail:            false,
@@ -457,9 +602,22 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// PeerScoreFile returns the full path to the peer score file
+func (cfg *P2PConfig) PeerScoreFile() string {
+	return rootify(cfg.PeerScore, cfg.RootDir)
+}
+
+// PeerBlacklistFile returns the full path to the peer blacklist file
+func (cfg *P2PConfig) PeerBlacklistFile() string {
+	return rootify(cfg.PeerBlacklist, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
+	if cfg.Protocol != "tcp" && cfg.Protocol != "quic" {
+		return errors.New("protocol must be either \"tcp\" or \"quic\"")
+	}
 	if cfg.MaxNumInboundPeers < 0 {
 		return errors.New("max_num_inbound_peers can't be negative")
 	}
@@ -478,6 +636,38 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if cfg.RecvMessageRate < 0 {
+		return errors.New("recv_message_rate can't be negative")
+	}
+	if cfg.RecvMessageBurst < 0 {
+		return errors.New("recv_message_burst can't be negative")
+	}
+	if cfg.CompressThreshold < 0 {
+		return errors.New("compress_threshold can't be negative")
+	}
+	if cfg.MinPingInterval < 0 {
+		return errors.New("min_ping_interval can't be negative")
+	}
+	if cfg.MaxPingInterval < 0 {
+		return errors.New("max_ping_interval can't be negative")
+	}
+	if cfg.MinPingInterval > cfg.MaxPingInterval {
+		return errors.New("min_ping_interval can't be greater than max_ping_interval")
+	}
+	if cfg.MaxPingInterval > 0 && cfg.MinPingInterval == 0 {
+		return errors.New("min_ping_interval must be positive if max_ping_interval is set")
+	}
+	if cfg.DedupCapacity < 0 {
+		return errors.New("dedup_capacity can't be negative")
+	}
+	if cfg.DedupCapacity > 0 {
+		if cfg.DedupFalsePositive <= 0 || cfg.DedupFalsePositive >= 1 {
+			return errors.New("dedup_false_positive_rate must be between 0 and 1")
+		}
+		if cfg.DedupRotateInterval <= 0 {
+			return errors.New("dedup_rotate_interval must be positive")
+		}
+	}
 	return nil
 }
 
@@ -512,6 +702,22 @@ type MempoolConfig struct {
 	WalPath   string `mapstructure:"wal_dir"`
 	Size      int    `mapstructure:"size"`
 	CacheSize int    `mapstructure:"cache_size"`
+	// Maximum gas a single tx may want (-1 means no limit)
+	MaxBlockGas int64 `mapstructure:"max_block_gas"`
+	// Remove txs not included after that many blocks (0 means never)
+	MaxTxTTLBlocks int64 `mapstructure:"max_tx_ttl_blocks"`
+	// Remove txs not included after that long (0 means never)
+	MaxTxTTLDuration time.Duration `mapstructure:"max_tx_ttl_duration"`
+	// File to save pending txs to, to reload them on restart ("" disables it)
+	PersistenceFile string `mapstructure:"persistence_file"`
+	// Only reap txs after the txs they depend on
+	EnableDependencyGraph bool `mapstructure:"enable_dependency_graph"`
+	// Maximum total size of the txs, in bytes (0 means no limit)
+	MaxTotalBytesSize int64 `mapstructure:"max_total_bytes_size"`
+	// Txs to evict above MaxTotalBytesSize: "fifo" or "priority"
+	EvictionPolicy string `mapstructure:"eviction_policy"`
+	// Number of connections to the application to recheck txs with
+	RecheckWorkers int `mapstructure:"recheck_workers"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool
@@ -522,8 +728,16 @@ func DefaultMempoolConfig() *MempoolConfig {
 		WalPath:   "",
 		// Each signature verification takes .5ms, size reduced until we implement
 		// ABCI Recheck
-		Size:      5000,
-		CacheSize: 10000,
+		Size:                  5000,
+		CacheSize:             10000,
+		MaxBlockGas:           -1,
+		MaxTxTTLBlocks:        0,
+		MaxTxTTLDuration:      0,
+		PersistenceFile:       "",
+		EnableDependencyGraph: false,
+		MaxTotalBytesSize:     0,
+		EvictionPolicy:        "fifo",
+		RecheckWorkers:        1,
 	}
 }
 
@@ -544,6 +758,16 @@ func (cfg *MempoolConfig) WalEnabled() bool {
 	return cfg.WalPath != ""
 }
 
+// PersistencePath returns the full path to the file pending txs are saved to
+func (cfg *MempoolConfig) PersistencePath() string {
+	return rootify(cfg.PersistenceFile, cfg.RootDir)
+}
+
+// PersistenceEnabled returns true if pending txs are saved.
+func (cfg *MempoolConfig) PersistenceEnabled() bool {
+	return cfg.PersistenceFile != ""
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
@@ -553,6 +777,24 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.CacheSize < 0 {
 		return errors.New("cache_size can't be negative")
 	}
+	if cfg.RecheckWorkers < 1 {
+		return errors.New("recheck_workers must be positive")
+	}
+	if cfg.MaxBlockGas < -1 {
+		return errors.New("max_block_gas can't be less than -1")
+	}
+	if cfg.MaxTxTTLBlocks < 0 {
+		return errors.New("max_tx_ttl_blocks can't be negative")
+	}
+	if cfg.MaxTxTTLDuration < 0 {
+		return errors.New("max_tx_ttl_duration can't be negative")
+	}
+	if cfg.MaxTotalBytesSize < 0 {
+		return errors.New("max_total_bytes_size can't be negative")
+	}
+	if cfg.EvictionPolicy != "fifo" && cfg.EvictionPolicy != "priority" {
+		return errors.New("eviction_policy must be either \"fifo\" or \"priority\"")
+	}
 	return nil
 }
 
@@ -566,6 +808,10 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// Save the locked and valid blocks next to the WAL whenever they change, so
+	// that only the WAL written after them is replayed on restart
+	SaveRoundState bool `mapstructure:"save_round_state"`
+
 	TimeoutPropose        time.Duration `mapstructure:"timeout_propose"`
 	TimeoutProposeDelta   time.Duration `mapstructure:"timeout_propose_delta"`
 	TimeoutPrevote        time.Duration `mapstructure:"timeout_prevote"`
@@ -574,9 +820,19 @@ type ConsensusConfig struct {
 	TimeoutPrecommitDelta time.Duration `mapstructure:"timeout_precommit_delta"`
 	TimeoutCommit         time.Duration `mapstructure:"timeout_commit"`
 
+	// Double the propose timeout every round, up to MaxTimeoutPropose, instead
+	// of adding TimeoutProposeDelta
+	TimeoutProposeBackoff bool          `mapstructure:"timeout_propose_backoff"`
+	MaxTimeoutPropose     time.Duration `mapstructure:"max_timeout_propose"`
+
 	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 	SkipTimeoutCommit bool `mapstructure:"skip_timeout_commit"`
 
+	// Propose the next block as soon as the previous one is committed (as if
+	// TimeoutCommit = 0) if we are its proposer. The block's LastCommit then
+	// only includes the precommits received by that time.
+	ProposeOptimistic bool `mapstructure:"propose_optimistic"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -587,12 +843,22 @@ type ConsensusConfig struct {
 
 	// Block time parameters. Corresponds to the minimum time increment between consecutive blocks.
 	BlockTimeIota time.Duration `mapstructure:"blocktime_iota"`
+
+	// Proposer selection algorithm: "round_robin" or "weighted_random".
+	// All validators of a chain must use the same one.
+	ProposerSelection string `mapstructure:"proposer_selection"`
+
+	// Number of votes per 30s window remembered to drop votes already added
+	// to the consensus state before verifying their signature again. Set to 0
+	// to disable.
+	VoteDedupCapacity int `mapstructure:"vote_dedup_capacity"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
 func DefaultConsensusConfig() *ConsensusConfig {
 	return &ConsensusConfig{
 		WalPath:                     filepath.Join(defaultDataDir, "cs.wal", "wal"),
+		SaveRoundState:              true,
 		TimeoutPropose:              3000 * time.Millisecond,
 		TimeoutProposeDelta:         500 * time.Millisecond,
 		TimeoutPrevote:              1000 * time.Millisecond,
@@ -600,12 +866,17 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutPrecommit:            1000 * time.Millisecond,
 		TimeoutPrecommitDelta:       500 * time.Millisecond,
 		TimeoutCommit:               1000 * time.Millisecond,
+		TimeoutProposeBackoff:       false,
+		MaxTimeoutPropose:           60 * time.Second,
 		SkipTimeoutCommit:           false,
+		ProposeOptimistic:           false,
 		CreateEmptyBlocks:           true,
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		BlockTimeIota:               1000 * time.Millisecond,
+		ProposerSelection:           "round_robin",
+		VoteDedupCapacity:           0,
 	}
 }
 
@@ -639,6 +910,16 @@ func (cfg *ConsensusConfig) WaitForTxs() bool {
 
 // Propose returns the amount of time to wait for a proposal
 func (cfg *ConsensusConfig) Propose(round int) time.Duration {
+	if cfg.TimeoutProposeBackoff {
+		timeout := cfg.TimeoutPropose
+		for i := 0; i < round && timeout < cfg.MaxTimeoutPropose; i++ {
+			timeout *= 2
+		}
+		if timeout > cfg.MaxTimeoutPropose {
+			timeout = cfg.MaxTimeoutPropose
+		}
+		return timeout
+	}
 	return time.Duration(
 		cfg.TimeoutPropose.Nanoseconds()+cfg.TimeoutProposeDelta.Nanoseconds()*int64(round),
 	) * time.Nanosecond
@@ -685,6 +966,9 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutProposeDelta < 0 {
 		return errors.New("timeout_propose_delta can't be negative")
 	}
+	if cfg.TimeoutProposeBackoff && cfg.MaxTimeoutPropose < cfg.TimeoutPropose {
+		return errors.New("max_timeout_propose can't be less than timeout_propose")
+	}
 	if cfg.TimeoutPrevote < 0 {
 		return errors.New("timeout_prevote can't be negative")
 	}
@@ -712,6 +996,12 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.BlockTimeIota < 0 {
 		return errors.New("blocktime_iota can't be negative")
 	}
+	if cfg.ProposerSelection != "round_robin" && cfg.ProposerSelection != "weighted_random" {
+		return errors.New("proposer_selection must be either \"round_robin\" or \"weighted_random\"")
+	}
+	if cfg.VoteDedupCapacity < 0 {
+		return errors.New("vote_dedup_capacity can't be negative")
+	}
 	return nil
 }
 
@@ -760,6 +1050,116 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+//-----------------------------------------------------------------------------
+// StoreConfig
+
+// StoreConfig defines the configuration for the block and state stores.
+type StoreConfig struct {
+	// Which blocks, and their ABCI responses, to keep in the stores
+	//
+	// Options:
+	//   1) "nothing" (default) - keep all the blocks.
+	//   2) "everything" - keep only the latest 2 blocks, which consensus needs.
+	//   3) "custom" - keep the latest PruningKeepRecent blocks, and every
+	//   PruningKeepEvery-th block for historical queries.
+	//
+	// Blocks are pruned asynchronously, after each committed block. Note that
+	// peers can't fast sync pruned blocks from this node.
+	PruningStrategy string `mapstructure:"pruning_strategy"`
+
+	// Number of latest blocks to keep with the "custom" pruning strategy
+	// (at least 2)
+	PruningKeepRecent int64 `mapstructure:"pruning_keep_recent"`
+
+	// Keep every block whose height is a multiple of this with the "custom"
+	// pruning strategy. 0 - none.
+	PruningKeepEvery int64 `mapstructure:"pruning_keep_every"`
+
+	// Number of blocks following a loaded block to read into memory ahead of
+	// time, e.g. to serve them faster to peers fast syncing from this node.
+	// 0 - disabled. Note that each block can take up to max_bytes of memory.
+	ReadAheadBlocks int `mapstructure:"read_ahead_blocks"`
+
+	// If true, each block is written to the block store in a single batch,
+	// flushed once, rather than part by part.
+	WriteBatch bool `mapstructure:"write_batch"`
+
+	// If true, the blocks in the block store are verified against their
+	// hashes in the background on start, logging the corrupted ones.
+	VerifyOnStart bool `mapstructure:"verify_on_start"`
+}
+
+// DefaultStoreConfig returns a default configuration for the stores.
+func DefaultStoreConfig() *StoreConfig {
+	return &StoreConfig{
+		PruningStrategy:   "nothing",
+		PruningKeepRecent: 100,
+		PruningKeepEvery:  10000,
+		ReadAheadBlocks:   0,
+		WriteBatch:        false,
+		VerifyOnStart:     true,
+	}
+}
+
+// TestStoreConfig returns a configuration for the stores for testing.
+func TestStoreConfig() *StoreConfig {
+	return DefaultStoreConfig()
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StoreConfig) ValidateBasic() error {
+	switch cfg.PruningStrategy {
+	case "nothing", "everything", "custom":
+	default:
+		return fmt.Errorf("unknown pruning_strategy %q", cfg.PruningStrategy)
+	}
+	if cfg.PruningKeepRecent < 0 {
+		return errors.New("pruning_keep_recent can't be negative")
+	}
+	if cfg.PruningKeepEvery < 0 {
+		return errors.New("pruning_keep_every can't be negative")
+	}
+	if cfg.ReadAheadBlocks < 0 {
+		return errors.New("read_ahead_blocks can't be negative")
+	}
+	if cfg.PruningStrategy == "custom" && cfg.PruningKeepRecent == 0 {
+		return errors.New("pruning_keep_recent must be positive with the custom pruning_strategy")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// EvidenceConfig
+
+// EvidenceConfig defines the configuration for the evidence pool.
+type EvidenceConfig struct {
+	// Limit the total size of the pending evidence in the pool, in bytes:
+	// above it, the oldest evidence is evicted. 0 - no limit.
+	MaxPoolSize int `mapstructure:"max_pool_size"`
+}
+
+// DefaultEvidenceConfig returns a default configuration for the evidence pool.
+func DefaultEvidenceConfig() *EvidenceConfig {
+	return &EvidenceConfig{
+		MaxPoolSize: 0,
+	}
+}
+
+// TestEvidenceConfig returns a configuration for the evidence pool for testing.
+func TestEvidenceConfig() *EvidenceConfig {
+	return DefaultEvidenceConfig()
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *EvidenceConfig) ValidateBasic() error {
+	if cfg.MaxPoolSize < 0 {
+		return errors.New("max_pool_size can't be negative")
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
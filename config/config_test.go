@@ -36,3 +36,64 @@ func TestConfigValidateBasic(t *testing.T) {
 	cfg.Consensus.TimeoutPropose = -10 * time.Second
 	assert.Error(t, cfg.ValidateBasic())
 }
+
+func TestConsensusConfigPropose(t *testing.T) {
+	cfg := DefaultConsensusConfig()
+	cfg.TimeoutPropose = time.Second
+	cfg.TimeoutProposeDelta = 500 * time.Millisecond
+	assert.Equal(t, time.Second, cfg.Propose(0))
+	assert.Equal(t, 2500*time.Millisecond, cfg.Propose(3))
+
+	cfg.TimeoutProposeBackoff = true
+	cfg.MaxTimeoutPropose = 10 * time.Second
+	assert.Equal(t, time.Second, cfg.Propose(0))
+	assert.Equal(t, 2*time.Second, cfg.Propose(1))
+	assert.Equal(t, 8*time.Second, cfg.Propose(3))
+	assert.Equal(t, 10*time.Second, cfg.Propose(4))
+	assert.Equal(t, 10*time.Second, cfg.Propose(1000))
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.MaxTimeoutPropose = 500 * time.Millisecond
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestP2PConfigValidateBasic(t *testing.T) {
+	cfg := DefaultP2PConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.MaxPingInterval = 60 * time.Second
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MinPingInterval = 10 * time.Second
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.MinPingInterval = 90 * time.Second
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestEvidenceConfigValidateBasic(t *testing.T) {
+	cfg := DefaultEvidenceConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.MaxPoolSize = 0
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.MaxPoolSize = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestStoreConfigValidateBasic(t *testing.T) {
+	cfg := DefaultStoreConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.PruningStrategy = "some"
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.PruningStrategy = "custom"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.PruningKeepRecent = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PruningKeepRecent = 1
+	cfg.PruningKeepEvery = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PruningKeepEvery = 0
+	cfg.ReadAheadBlocks = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
@@ -125,13 +125,13 @@ laddr = "{{ .RPC.ListenAddress }}"
 # A list of origins a cross-domain request can be executed from
 # Default value '[]' disables cors support
 # Use '["*"]' to allow any origin
-cors_allowed_origins = "{{ .RPC.CORSAllowedOrigins }}"
+cors_allowed_origins = [{{ range .RPC.CORSAllowedOrigins }}{{ printf "%q, " . }}{{end}}]
 
 # A list of methods the client is allowed to use with cross-domain requests
-cors_allowed_methods = "{{ .RPC.CORSAllowedMethods }}"
+cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}{{end}}]
 
 # A list of non simple headers the client is allowed to use with cross-domain requests
-cors_allowed_headers = "{{ .RPC.CORSAllowedHeaders }}"
+cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
 # TCP or UNIX socket address for the gRPC server to listen on
 # NOTE: This server only supports /broadcast_tx_commit
@@ -158,6 +158,47 @@ unsafe = {{ .RPC.Unsafe }}
 # 1024 - 40 - 10 - 50 = 924 = ~900
 max_open_connections = {{ .RPC.MaxOpenConnections }}
 
+# Maximum number of requests per second from a single IP (including
+# WebSocket handshakes, but not the requests sent over a WebSocket).
+# Requests above the limit receive HTTP 429 with a Retry-After header.
+# gRPC calls (grpc_laddr) above it fail with RESOURCE_EXHAUSTED.
+# 0 - unlimited.
+max_requests_per_second = {{ .RPC.MaxRequestsPerSecond }}
+
+# Maximum number of requests a single IP can send at once, on top of
+# max_requests_per_second.
+# 0 - same as max_requests_per_second.
+max_requests_burst = {{ .RPC.MaxRequestsBurst }}
+
+# IPs and CIDR blocks (e.g. "10.0.0.0/8") not subject to
+# max_requests_per_second.
+rate_limit_whitelist = [{{ range .RPC.RateLimitWhitelist }}{{ printf "%q, " . }}{{end}}]
+
+# Secret used to sign the tokens authenticating RPC requests (see the
+# rpc_token command), at least 32 characters long. If set, requests must
+# include an "Authorization: Bearer <token>" header, and gRPC calls the
+# same "authorization" metadata.
+# Empty - no authentication.
+jwt_secret = "{{ .RPC.JWTSecret }}"
+
+# Endpoints not requiring authentication when jwt_secret is set
+# (e.g. ["status", "block"]).
+public_endpoints = [{{ range .RPC.PublicEndpoints }}{{ printf "%q, " . }}{{end}}]
+
+# Maximum number of subscriptions a single WebSocket client can make.
+# 0 - unlimited.
+max_subscriptions_per_client = {{ .RPC.MaxSubscriptionsPerClient }}
+
+# Number of /block and /block_results results of committed blocks, which
+# never change, to keep in memory for repeated queries.
+# 0 - no caching.
+result_cache_size = {{ .RPC.ResultCacheSize }}
+
+# The endpoints are served under /v1/ (e.g. /v1/status), and at the root
+# (e.g. /status) until the next major version. If true, the responses
+# for the unversioned paths include a Deprecation header.
+deprecate_legacy_paths = {{ .RPC.DeprecateLegacyPaths }}
+
 ##### peer to peer configuration options #####
 [p2p]
 
@@ -177,6 +218,8 @@ seeds = "{{ .P2P.Seeds }}"
 persistent_peers = "{{ .P2P.PersistentPeers }}"
 
 # UPNP port forwarding
+# Opens the P2P port on the router with UPnP or NAT-PMP and advertises the
+# router's external address to peers instead of external_address
 upnp = {{ .P2P.UPNP }}
 
 # Path to address book
@@ -186,6 +229,12 @@ addr_book_file = "{{ js .P2P.AddrBook }}"
 # Set false for private or local networks
 addr_book_strict = {{ .P2P.AddrBookStrict }}
 
+# Path to the file where peer scores are persisted across restarts
+peer_score_file = "{{ js .P2P.PeerScore }}"
+
+# Path to the file where blacklisted peers are persisted across restarts
+peer_blacklist_file = "{{ js .P2P.PeerBlacklist }}"
+
 # Maximum number of inbound peers
 max_num_inbound_peers = {{ .P2P.MaxNumInboundPeers }}
 
@@ -204,6 +253,37 @@ send_rate = {{ .P2P.SendRate }}
 # Rate at which packets can be received, in bytes/second
 recv_rate = {{ .P2P.RecvRate }}
 
+# Maximum rate of inbound messages per peer, in messages/second.
+# Messages above the rate are dropped and the peer's score is decremented.
+# 0 disables the limit.
+recv_message_rate = {{ .P2P.RecvMessageRate }}
+
+# Maximum number of inbound messages per peer allowed in a single burst
+recv_message_burst = {{ .P2P.RecvMessageBurst }}
+
+# Messages larger than this many bytes are gzip compressed when sent to
+# peers which support compression. 0 disables compression.
+compress_threshold = {{ .P2P.CompressThreshold }}
+
+# Bounds of the adaptive ping interval. Peers with a stable latency are
+# pinged every max_ping_interval, peers with a jittery one up to every
+# min_ping_interval, which must be positive. A max_ping_interval of 0 keeps
+# the fixed 60s interval.
+min_ping_interval = "{{ .P2P.MinPingInterval }}"
+max_ping_interval = "{{ .P2P.MaxPingInterval }}"
+
+# Drop transactions already received from another peer, as tracked by a
+# rolling bloom filter. dedup_capacity is the number of messages remembered
+# per dedup_rotate_interval at the given false positive rate; 0 disables
+# deduplication. Votes are not deduplicated here, as a false positive would
+# lose a vote for good; see vote_dedup_capacity instead.
+dedup_capacity = {{ .P2P.DedupCapacity }}
+dedup_false_positive_rate = {{ .P2P.DedupFalsePositive }}
+dedup_rotate_interval = "{{ .P2P.DedupRotateInterval }}"
+
+# Decrement the score of peers sending duplicate messages
+dedup_penalize = {{ .P2P.DedupPenalize }}
+
 # Set true to enable the peer-exchange reactor
 pex = {{ .P2P.PexReactor }}
 
@@ -220,6 +300,8 @@ private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
 # Peer connection configuration.
+# Transport protocol for peer connections: "tcp" or "quic"
+protocol = "{{ .P2P.Protocol }}"
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
 
@@ -230,17 +312,52 @@ recheck = {{ .Mempool.Recheck }}
 broadcast = {{ .Mempool.Broadcast }}
 wal_dir = "{{ js .Mempool.WalPath }}"
 
+# number of connections to the application to recheck txs with after a block.
+# With more than 1, the txs are sent to each in turn, so the application
+# receives concurrent CheckTx calls; the responses are still handled in the
+# order of the txs. Keep 1 if the application relies on serial CheckTx calls
+recheck_workers = {{ .Mempool.RecheckWorkers }}
+
 # size of the mempool
 size = {{ .Mempool.Size }}
 
 # size of the cache (used to filter transactions we saw earlier)
 cache_size = {{ .Mempool.CacheSize }}
 
+# reject txs wanting more gas than this, as they would never fit in a block
+# (-1 means no limit)
+max_block_gas = {{ .Mempool.MaxBlockGas }}
+
+# remove txs that are not included in a block after max_tx_ttl_blocks blocks
+# or max_tx_ttl_duration, whichever comes first (0 means never)
+max_tx_ttl_blocks = {{ .Mempool.MaxTxTTLBlocks }}
+max_tx_ttl_duration = "{{ .Mempool.MaxTxTTLDuration }}"
+
+# save pending txs to this file, and reload them on restart. Added and removed
+# txs are appended as they come and go, so that they also survive a crash
+# ("" disables it)
+persistence_file = "{{ js .Mempool.PersistenceFile }}"
+
+# only include a tx in a block after the txs it depends on, listed by the
+# application in the mempool.depends_on tags of the CheckTx response
+enable_dependency_graph = {{ .Mempool.EnableDependencyGraph }}
+
+# maximum total size of the txs in the mempool, in bytes (0 means no limit).
+# Above it, txs are evicted according to the eviction_policy:
+# 1) "fifo" evicts the txs received first
+# 2) "priority" evicts the txs of the lowest priority, received last
+max_total_bytes_size = {{ .Mempool.MaxTotalBytesSize }}
+eviction_policy = "{{ .Mempool.EvictionPolicy }}"
+
 ##### consensus configuration options #####
 [consensus]
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# Save the locked and valid blocks next to the WAL whenever they change, so
+# that only the WAL written after them is replayed on restart
+save_round_state = {{ .Consensus.SaveRoundState }}
+
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 timeout_propose_delta = "{{ .Consensus.TimeoutProposeDelta }}"
 timeout_prevote = "{{ .Consensus.TimeoutPrevote }}"
@@ -249,9 +366,20 @@ timeout_precommit = "{{ .Consensus.TimeoutPrecommit }}"
 timeout_precommit_delta = "{{ .Consensus.TimeoutPrecommitDelta }}"
 timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 
+# Double the propose timeout every round, up to max_timeout_propose, instead of
+# adding timeout_propose_delta. Nodes then move on quickly if the proposer is
+# briefly offline, but wait longer when many rounds fail
+timeout_propose_backoff = {{ .Consensus.TimeoutProposeBackoff }}
+max_timeout_propose = "{{ .Consensus.MaxTimeoutPropose }}"
+
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
+# Propose the next block as soon as the previous one is committed (as if
+# TimeoutCommit = 0) if this node is its proposer. The block then only includes
+# the precommits received by that time
+propose_optimistic = {{ .Consensus.ProposeOptimistic }}
+
 # EmptyBlocks mode and possible interval between empty blocks
 create_empty_blocks = {{ .Consensus.CreateEmptyBlocks }}
 create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
@@ -263,6 +391,15 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # Block time parameters. Corresponds to the minimum time increment between consecutive blocks.
 blocktime_iota = "{{ .Consensus.BlockTimeIota }}"
 
+# Proposer selection algorithm: "round_robin" or "weighted_random".
+# All validators of a chain must use the same one.
+proposer_selection = "{{ .Consensus.ProposerSelection }}"
+
+# Number of votes per 30s window remembered to drop votes already added to
+# the consensus state before verifying their signature again. Set to 0 to
+# disable.
+vote_dedup_capacity = {{ .Consensus.VoteDedupCapacity }}
+
 ##### transactions indexer configuration options #####
 [tx_index]
 
@@ -290,6 +427,49 @@ index_tags = "{{ .TxIndex.IndexTags }}"
 # indexed).
 index_all_tags = {{ .TxIndex.IndexAllTags }}
 
+##### store configuration options #####
+[store]
+
+# Which blocks, and their ABCI responses, to keep in the stores
+#
+# Options:
+#   1) "nothing" (default) - keep all the blocks.
+#   2) "everything" - keep only the latest 2 blocks, which consensus needs.
+#   3) "custom" - keep the latest pruning_keep_recent blocks, and every
+#   pruning_keep_every-th block for historical queries.
+#
+# Blocks are pruned asynchronously, after each committed block. Note that
+# peers can't fast sync pruned blocks from this node.
+pruning_strategy = "{{ .Store.PruningStrategy }}"
+
+# Number of latest blocks to keep with the "custom" pruning strategy
+# (at least 2)
+pruning_keep_recent = {{ .Store.PruningKeepRecent }}
+
+# Keep every block whose height is a multiple of this with the "custom"
+# pruning strategy. 0 - none.
+pruning_keep_every = {{ .Store.PruningKeepEvery }}
+
+# Number of blocks following a loaded block to read into memory ahead of
+# time, e.g. to serve them faster to peers fast syncing from this node.
+# 0 - disabled. Note that each block can take up to max_bytes of memory.
+read_ahead_blocks = {{ .Store.ReadAheadBlocks }}
+
+# If true, each block is written to the block store in a single batch,
+# flushed once, rather than part by part.
+write_batch = {{ .Store.WriteBatch }}
+
+# If true, the blocks in the block store are verified against their
+# hashes in the background on start, logging the corrupted ones.
+verify_on_start = {{ .Store.VerifyOnStart }}
+
+##### evidence pool configuration options #####
+[evidence]
+
+# Limit the total size of the pending evidence in the pool, in bytes:
+# above it, the oldest evidence is evicted. 0 - no limit.
+max_pool_size = {{ .Evidence.MaxPoolSize }}
+
 ##### instrumentation configuration options #####
 [instrumentation]
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -63,6 +64,34 @@ func TestEnsureTestRoot(t *testing.T) {
 	ensureFiles(t, rootDir, defaultDataDir, baseConfig.Genesis, baseConfig.PrivValidator)
 }
 
+func TestWriteConfigFileLists(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "config-test")
+	require.Nil(t, err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	config := DefaultConfig()
+	config.RPC.CORSAllowedOrigins = []string{"https://example.com", "*"}
+	configFile := filepath.Join(tmpDir, "config.toml")
+	WriteConfigFile(configFile, config)
+
+	// the lists are read back as lists
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	require.Nil(t, v.ReadInConfig())
+	read := DefaultConfig()
+	require.Nil(t, v.Unmarshal(read))
+	assert.Equal(t, config.RPC.CORSAllowedOrigins, read.RPC.CORSAllowedOrigins)
+	assert.Equal(t, config.RPC.CORSAllowedMethods, read.RPC.CORSAllowedMethods)
+	assert.Equal(t, config.RPC.CORSAllowedHeaders, read.RPC.CORSAllowedHeaders)
+
+	// no CORS by default
+	WriteConfigFile(configFile, DefaultConfig())
+	require.Nil(t, v.ReadInConfig())
+	read = DefaultConfig()
+	require.Nil(t, v.Unmarshal(read))
+	assert.False(t, read.RPC.IsCorsEnabled())
+}
+
 func checkConfig(configFile string) bool {
 	var valid bool
 
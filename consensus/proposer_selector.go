@@ -0,0 +1,101 @@
+package consensus
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// Values of ConsensusConfig.ProposerSelection.
+	ProposerSelectionRoundRobin     = "round_robin"
+	ProposerSelectionWeightedRandom = "weighted_random"
+)
+
+// ProposerSelector chooses the proposer of a round.
+//
+// NOTE: All validators of a chain must use the same ProposerSelector, or they
+// won't agree on the proposer and will reject each other's proposals.
+type ProposerSelector interface {
+	// SelectProposer returns the proposer for the given height and round.
+	// validators is the validator set of the height, with its proposer
+	// priorities already incremented for the round.
+	SelectProposer(validators *types.ValidatorSet, height int64, round int) *types.Validator
+}
+
+// NewProposerSelector returns the ProposerSelector for the given
+// ConsensusConfig.ProposerSelection, defaulting to round robin.
+func NewProposerSelector(selection string) ProposerSelector {
+	if selection == ProposerSelectionWeightedRandom {
+		return &WeightedRandomProposerSelector{}
+	}
+	return RoundRobinProposerSelector{}
+}
+
+//-----------------------------------------------------------------------------
+
+// RoundRobinProposerSelector selects the proposer of the validator set, which
+// rotates through validators in proportion to their voting power.
+type RoundRobinProposerSelector struct{}
+
+var _ ProposerSelector = RoundRobinProposerSelector{}
+
+// SelectProposer implements ProposerSelector.
+func (RoundRobinProposerSelector) SelectProposer(validators *types.ValidatorSet, height int64, round int) *types.Validator {
+	return validators.GetProposer()
+}
+
+//-----------------------------------------------------------------------------
+
+// WeightedRandomProposerSelector selects a validator at random, with a
+// probability proportional to its voting power. The randomness is derived
+// from the validator set, the height and the round, so every node selects the
+// same proposer.
+//
+// The hash of the last validator set is cached, as the set only changes
+// between heights. Validator sets must therefore not be updated in place once
+// a proposer was selected from them, which consensus never does.
+type WeightedRandomProposerSelector struct {
+	mtx      sync.Mutex
+	vals     *types.ValidatorSet
+	valsHash []byte
+}
+
+var _ ProposerSelector = (*WeightedRandomProposerSelector)(nil)
+
+// SelectProposer implements ProposerSelector.
+func (s *WeightedRandomProposerSelector) SelectProposer(validators *types.ValidatorSet, height int64, round int) *types.Validator {
+	total := validators.TotalVotingPower()
+	if validators.Size() == 0 || total <= 0 {
+		return nil
+	}
+
+	seed := make([]byte, 16)
+	binary.BigEndian.PutUint64(seed[:8], uint64(height))
+	binary.BigEndian.PutUint64(seed[8:], uint64(round))
+	hash := tmhash.Sum(append(s.validatorsHash(validators), seed...))
+
+	// Pick a point in [0, total) and return the validator whose share of the
+	// voting power covers it. (The modulo bias is negligible for a 64 bit
+	// random number.)
+	point := int64(binary.BigEndian.Uint64(hash[:8]) % uint64(total))
+	for _, val := range validators.Validators {
+		if point < val.VotingPower {
+			return val
+		}
+		point -= val.VotingPower
+	}
+	return nil // unreachable
+}
+
+func (s *WeightedRandomProposerSelector) validatorsHash(validators *types.ValidatorSet) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.vals != validators {
+		s.vals = validators
+		s.valsHash = validators.Hash()
+	}
+	return s.valsHash
+}
@@ -0,0 +1,59 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/counter"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestRoundRobinProposerSelector(t *testing.T) {
+	vals, _ := types.RandValidatorSet(4, 10)
+	selector := RoundRobinProposerSelector{}
+
+	for round := 0; round < 8; round++ {
+		assert.Equal(t, vals.GetProposer(), selector.SelectProposer(vals, 1, round))
+		vals.IncrementProposerPriority(1)
+	}
+}
+
+func TestWeightedRandomProposerSelector(t *testing.T) {
+	heavy := types.NewValidator(ed25519.GenPrivKey().PubKey(), 90)
+	light := types.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+	vals := types.NewValidatorSet([]*types.Validator{heavy, light})
+	selector := &WeightedRandomProposerSelector{}
+
+	counts := make(map[string]int)
+	for height := int64(1); height <= 1000; height++ {
+		proposer := selector.SelectProposer(vals, height, 0)
+		require.NotNil(t, proposer)
+
+		// deterministic
+		assert.Equal(t, proposer, selector.SelectProposer(vals, height, 0))
+
+		counts[string(proposer.Address)]++
+	}
+
+	// proportional to voting power
+	assert.InDelta(t, 900, counts[string(heavy.Address)], 60)
+	assert.InDelta(t, 100, counts[string(light.Address)], 60)
+}
+
+func TestNewProposerSelector(t *testing.T) {
+	assert.IsType(t, RoundRobinProposerSelector{}, NewProposerSelector(ProposerSelectionRoundRobin))
+	assert.IsType(t, &WeightedRandomProposerSelector{}, NewProposerSelector(ProposerSelectionWeightedRandom))
+	assert.IsType(t, RoundRobinProposerSelector{}, NewProposerSelector(""))
+}
+
+func TestConsensusStateProposerSelection(t *testing.T) {
+	thisConfig := ResetConfig("consensus_proposer_selection_test")
+	thisConfig.Consensus.ProposerSelection = ProposerSelectionWeightedRandom
+
+	state, privVals := randGenesisState(1, false, 10)
+	cs := newConsensusStateWithConfig(thisConfig, state, privVals[0], counter.NewCounterApplication(true))
+	assert.IsType(t, &WeightedRandomProposerSelector{}, cs.proposerSelector)
+}
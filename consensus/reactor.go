@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"sync"
@@ -44,6 +45,9 @@ type ConsensusReactor struct {
 	eventBus *types.EventBus
 
 	metrics *Metrics
+
+	// drops votes already received from another peer (nil if disabled)
+	voteDedup *p2p.MessageDeduplicator
 }
 
 type ReactorOption func(*ConsensusReactor)
@@ -302,6 +306,11 @@ func (conR *ConsensusReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
 			ps.SetHasVote(msg.Vote)
 
+			// Another peer already gave us this vote.
+			if conR.isDuplicateVote(msg.Vote) {
+				return
+			}
+
 			cs.peerMsgQueue <- msgInfo{msg, src.ID()}
 
 		default:
@@ -381,7 +390,9 @@ func (conR *ConsensusReactor) subscribeToBroadcastEvents() {
 
 	conR.conS.evsw.AddListenerForEvent(subscriber, types.EventVote,
 		func(data tmevents.EventData) {
-			conR.broadcastHasVoteMessage(data.(*types.Vote))
+			vote := data.(*types.Vote)
+			conR.rememberVote(vote)
+			conR.broadcastHasVoteMessage(vote)
 		})
 
 }
@@ -491,8 +502,11 @@ OUTER_LOOP:
 			if prs.ProposalBlockParts == nil {
 				blockMeta := conR.conS.blockStore.LoadBlockMeta(prs.Height)
 				if blockMeta == nil {
-					cmn.PanicCrisis(fmt.Sprintf("Failed to load block %d when blockStore is at %d",
-						prs.Height, conR.conS.blockStore.Height()))
+					// the block may have been pruned
+					heightLogger.Error("Failed to load block meta",
+						"ourHeight", rs.Height, "blockstoreHeight", conR.conS.blockStore.Height())
+					time.Sleep(conR.conS.config.PeerGossipSleepDuration)
+					continue OUTER_LOOP
 				}
 				ps.InitProposalBlockParts(blockMeta.BlockID.PartsHeader)
 				// continue the loop since prs is a copy and not effected by this initialization
@@ -786,13 +800,15 @@ OUTER_LOOP:
 		{
 			prs := ps.GetRoundState()
 			if prs.CatchupCommitRound != -1 && 0 < prs.Height && prs.Height <= conR.conS.blockStore.Height() {
-				commit := conR.conS.LoadCommit(prs.Height)
-				peer.TrySend(StateChannel, cdc.MustMarshalBinaryBare(&VoteSetMaj23Message{
-					Height:  prs.Height,
-					Round:   commit.Round(),
-					Type:    types.PrecommitType,
-					BlockID: commit.BlockID,
-				}))
+				// nil if the block was pruned
+				if commit := conR.conS.LoadCommit(prs.Height); commit != nil {
+					peer.TrySend(StateChannel, cdc.MustMarshalBinaryBare(&VoteSetMaj23Message{
+						Height:  prs.Height,
+						Round:   commit.Round(),
+						Type:    types.PrecommitType,
+						BlockID: commit.BlockID,
+					}))
+				}
 				time.Sleep(conR.conS.config.PeerQueryMaj23SleepDuration)
 			}
 		}
@@ -881,6 +897,51 @@ func ReactorMetrics(metrics *Metrics) ReactorOption {
 	return func(conR *ConsensusReactor) { conR.metrics = metrics }
 }
 
+const (
+	voteDedupFalsePositiveRate = 0.000001
+	voteDedupRotateInterval    = 30 * time.Second
+)
+
+// ReactorVoteDedup makes the reactor drop votes it has already received,
+// before their signature is verified. capacity is the number of votes
+// remembered per 30s window.
+func ReactorVoteDedup(capacity int) ReactorOption {
+	return func(conR *ConsensusReactor) {
+		conR.voteDedup = p2p.NewMessageDeduplicator(capacity, voteDedupFalsePositiveRate, voteDedupRotateInterval)
+	}
+}
+
+// isDuplicateVote returns true if the vote was (probably) already added to
+// the ConsensusState. Unlike the Switch's deduplication of raw messages, it
+// recognizes the same vote however it was encoded or timestamped. The BlockID
+// is part of the key, so conflicting votes of a validator still reach the
+// ConsensusState as evidence of double signing.
+func (conR *ConsensusReactor) isDuplicateVote(vote *types.Vote) bool {
+	if conR.voteDedup == nil {
+		return false
+	}
+	return conR.voteDedup.Has(voteDedupKey(vote))
+}
+
+// rememberVote records a vote added to the ConsensusState. Votes are only
+// recorded once their signature was verified, so that a forged vote can't
+// get the real one dropped.
+func (conR *ConsensusReactor) rememberVote(vote *types.Vote) {
+	if conR.voteDedup != nil {
+		conR.voteDedup.Add(voteDedupKey(vote))
+	}
+}
+
+// voteDedupKey is (height, round, type, validator index, block hash).
+func voteDedupKey(vote *types.Vote) []byte {
+	key := make([]byte, 8+8+1+8, 8+8+1+8+len(vote.BlockID.Hash))
+	binary.BigEndian.PutUint64(key[0:], uint64(vote.Height))
+	binary.BigEndian.PutUint64(key[8:], uint64(vote.Round))
+	key[16] = byte(vote.Type)
+	binary.BigEndian.PutUint64(key[17:], uint64(vote.ValidatorIndex))
+	return append(key, vote.BlockID.Hash...)
+}
+
 //-----------------------------------------------------------------------------
 
 var (
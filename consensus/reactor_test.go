@@ -19,6 +19,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	bc "github.com/tendermint/tendermint/blockchain"
 	cfg "github.com/tendermint/tendermint/config"
+	cmn "github.com/tendermint/tendermint/libs/common"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	mempl "github.com/tendermint/tendermint/mempool"
@@ -255,6 +256,39 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	assert.Equal(t, true, ps.BlockPartsSent() > 0, "number of votes sent should have increased")
 }
 
+func TestReactorVoteDedup(t *testing.T) {
+	cs, vss := randConsensusState(1)
+	conR := NewConsensusReactor(cs, false, ReactorVoteDedup(100))
+
+	hash := cmn.RandBytes(20)
+	header := types.PartSetHeader{Total: 1, Hash: cmn.RandBytes(20)}
+	vote := signVote(vss[0], types.PrevoteType, hash, header)
+	// not a duplicate until it was added to the ConsensusState, so that a
+	// forged copy can't get the real vote dropped
+	assert.False(t, conR.isDuplicateVote(vote))
+	assert.False(t, conR.isDuplicateVote(vote))
+	conR.rememberVote(vote)
+	assert.True(t, conR.isDuplicateVote(vote))
+
+	// the same vote, signed again with another timestamp
+	resigned := vote.Copy()
+	resigned.Timestamp = resigned.Timestamp.Add(time.Second)
+	assert.True(t, conR.isDuplicateVote(resigned))
+
+	// a conflicting vote isn't dropped, it's evidence
+	conflicting := signVote(vss[0], types.PrevoteType, cmn.RandBytes(20), header)
+	assert.False(t, conR.isDuplicateVote(conflicting))
+
+	// a vote of another round isn't a duplicate
+	vss[0].Round++
+	assert.False(t, conR.isDuplicateVote(signVote(vss[0], types.PrevoteType, hash, header)))
+
+	// disabled by default
+	conR = NewConsensusReactor(cs, false)
+	conR.rememberVote(vote)
+	assert.False(t, conR.isDuplicateVote(vote))
+}
+
 //-------------------------------------------------------------
 // ensure we can make blocks despite cycling a validator set
 
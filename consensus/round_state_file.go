@@ -0,0 +1,233 @@
+package consensus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	cstypes "github.com/tendermint/tendermint/consensus/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/types"
+)
+
+const roundStateFileName = "round_state"
+
+// savedRoundState is the part of the RoundState needed to resume a round
+// without replaying the whole WAL. Votes are not saved: peers gossip them
+// again, and the PrivValidator refuses to sign anything conflicting with our
+// own votes.
+type savedRoundState struct {
+	Height int64                 `json:"height"`
+	Round  int                   `json:"round"`
+	Step   cstypes.RoundStepType `json:"step"`
+
+	LockedRound int          `json:"locked_round"`
+	LockedBlock *types.Block `json:"locked_block"`
+	ValidRound  int          `json:"valid_round"`
+	ValidBlock  *types.Block `json:"valid_block"`
+
+	// Position of the WAL tail when the state was saved. The messages written
+	// to the WAL after it are replayed on top of the saved state.
+	WALMaxIndex int   `json:"wal_max_index"`
+	WALHeadSize int64 `json:"wal_head_size"`
+}
+
+// roundStateKey identifies the locked and valid blocks of a height, so that
+// the round state is only saved when they change.
+type roundStateKey struct {
+	height      int64
+	lockedRound int
+	lockedBlock *types.Block
+	validRound  int
+	validBlock  *types.Block
+}
+
+// CONTRACT: cs.mtx is held.
+func (cs *ConsensusState) roundStateKey() roundStateKey {
+	return roundStateKey{
+		height:      cs.Height,
+		lockedRound: cs.LockedRound,
+		lockedBlock: cs.LockedBlock,
+		validRound:  cs.ValidRound,
+		validBlock:  cs.ValidBlock,
+	}
+}
+
+// RoundStateFile returns the path of the round state sidecar file of the WAL
+// at walFile (see ConsensusState.SaveRoundState).
+func RoundStateFile(walFile string) string {
+	return filepath.Join(filepath.Dir(walFile), roundStateFileName)
+}
+
+func (cs *ConsensusState) roundStateFile() string {
+	return RoundStateFile(cs.config.WalFile())
+}
+
+// SaveRoundState writes the current RoundState to a sidecar file of the WAL,
+// so that a restarted node can resume the round without replaying the whole
+// WAL. If ConsensusConfig.SaveRoundState is set, it is called on every new
+// height and whenever the locked or valid block changes. It does nothing if
+// there is no WAL.
+func (cs *ConsensusState) SaveRoundState() error {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.saveRoundState()
+}
+
+// CONTRACT: cs.mtx is held.
+func (cs *ConsensusState) saveRoundState() error {
+	group := cs.wal.Group()
+	if group == nil {
+		return nil
+	}
+	if err := group.Flush(); err != nil {
+		return err
+	}
+	headSize, err := group.Head.Size()
+	if err != nil {
+		return err
+	}
+
+	srs := savedRoundState{
+		Height:      cs.Height,
+		Round:       cs.Round,
+		Step:        cs.Step,
+		LockedRound: cs.LockedRound,
+		LockedBlock: cs.LockedBlock,
+		ValidRound:  cs.ValidRound,
+		ValidBlock:  cs.ValidBlock,
+		WALMaxIndex: group.MaxIndex(),
+		WALHeadSize: headSize,
+	}
+	data, err := cdc.MarshalBinaryBare(srs)
+	if err != nil {
+		return err
+	}
+
+	// crc (4 bytes) | amino encoded savedRoundState
+	bz := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(bz[0:4], crc32.Checksum(data, crc32c))
+	copy(bz[4:], data)
+	if err := cmn.WriteFileAtomic(cs.roundStateFile(), bz, 0600); err != nil {
+		return err
+	}
+	cs.savedRoundStateKey = cs.roundStateKey()
+	return nil
+}
+
+// loadRoundState reads the sidecar file written by SaveRoundState.
+func loadRoundState(file string) (*savedRoundState, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) < 4 {
+		return nil, DataCorruptionError{fmt.Errorf("round state file is too short (%d bytes)", len(bz))}
+	}
+
+	crc := binary.BigEndian.Uint32(bz[0:4])
+	data := bz[4:]
+	if actualCRC := crc32.Checksum(data, crc32c); actualCRC != crc {
+		return nil, DataCorruptionError{fmt.Errorf("checksums do not match: (read: %v, actual: %v)", crc, actualCRC)}
+	}
+
+	srs := new(savedRoundState)
+	if err := cdc.UnmarshalBinaryBare(data, srs); err != nil {
+		return nil, DataCorruptionError{fmt.Errorf("failed to decode round state: %v", err)}
+	}
+	return srs, nil
+}
+
+// restoreRoundState restores the locked and valid blocks from the sidecar
+// file if it is for the current height, its blocks extend the last block, and
+// the WAL it points into still exists, in which case only the WAL written after it needs to be replayed
+// (see replayWALSince). It returns the saved state, or nil if the whole WAL
+// needs to be replayed.
+func (cs *ConsensusState) restoreRoundState() *savedRoundState {
+	group := cs.wal.Group()
+	if group == nil {
+		return nil
+	}
+
+	srs, err := loadRoundState(cs.roundStateFile())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			cs.Logger.Error("Error loading round state. Replaying WAL", "err", err)
+		}
+		return nil
+	}
+
+	if srs.Height != cs.Height {
+		cs.Logger.Info("Saved round state is for another height. Replaying WAL",
+			"height", cs.Height, "savedHeight", srs.Height)
+		return nil
+	}
+	headSize, err := group.Head.Size()
+	if err != nil {
+		cs.Logger.Error("Error reading WAL size. Replaying WAL", "err", err)
+		return nil
+	}
+	if srs.WALMaxIndex < group.MinIndex() || srs.WALMaxIndex > group.MaxIndex() ||
+		srs.WALMaxIndex == group.MaxIndex() && srs.WALHeadSize > headSize {
+		cs.Logger.Info("WAL doesn't contain the saved round state position. Replaying WAL")
+		return nil
+	}
+	for _, block := range []*types.Block{srs.LockedBlock, srs.ValidBlock} {
+		if block != nil && (block.Height != cs.Height || !block.LastBlockID.Equals(cs.state.LastBlockID)) {
+			cs.Logger.Info("Saved round state has a block that isn't on top of the chain. Replaying WAL",
+				"height", block.Height, "lastBlockID", block.LastBlockID)
+			return nil
+		}
+	}
+
+	cs.LockedRound = srs.LockedRound
+	cs.LockedBlock = srs.LockedBlock
+	if srs.LockedBlock != nil {
+		cs.LockedBlockParts = srs.LockedBlock.MakePartSet(types.BlockPartSizeBytes)
+	}
+	cs.ValidRound = srs.ValidRound
+	cs.ValidBlock = srs.ValidBlock
+	if srs.ValidBlock != nil {
+		cs.ValidBlockParts = srs.ValidBlock.MakePartSet(types.BlockPartSizeBytes)
+	}
+
+	cs.Logger.Info("Restored round state", "height", srs.Height, "round", srs.Round, "step", srs.Step)
+	return srs
+}
+
+// replayWALSince replays the messages written to the WAL after the round
+// state was saved.
+func (cs *ConsensusState) replayWALSince(srs *savedRoundState) error {
+	// Set replayMode to true so we don't log signing errors.
+	cs.replayMode = true
+	defer func() { cs.replayMode = false }()
+
+	gr, err := cs.wal.Group().NewReader(srs.WALMaxIndex)
+	if err != nil {
+		return err
+	}
+	defer gr.Close() // nolint: errcheck
+
+	if _, err := io.CopyN(ioutil.Discard, gr, srs.WALHeadSize); err != nil {
+		return err
+	}
+
+	dec := WALDecoder{gr}
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if err := cs.readReplayMessage(msg, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
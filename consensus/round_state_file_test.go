@@ -0,0 +1,126 @@
+package consensus
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/tendermint/tendermint/consensus/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestRoundStateFileRestore(t *testing.T) {
+	thisConfig := ResetConfig("consensus_round_state_test")
+	defer os.RemoveAll(thisConfig.RootDir)
+
+	cs, _ := randConsensusState(1)
+	cs.config = thisConfig.Consensus
+	wal, err := cs.OpenWAL(cs.config.WalFile())
+	require.NoError(t, err)
+	defer wal.Stop()
+	cs.wal = wal
+
+	// nothing saved yet
+	assert.Nil(t, cs.restoreRoundState())
+
+	block, _ := cs.createProposalBlock()
+	require.NotNil(t, block)
+	cs.Round = 2
+	cs.LockedRound = 1
+	cs.LockedBlock = block
+	cs.LockedBlockParts = block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, cs.SaveRoundState())
+
+	cs.LockedRound, cs.LockedBlock, cs.LockedBlockParts = -1, nil, nil
+	srs := cs.restoreRoundState()
+	require.NotNil(t, srs)
+	assert.Equal(t, 2, srs.Round)
+	assert.Equal(t, 1, cs.LockedRound)
+	assert.Equal(t, block.Hash(), cs.LockedBlock.Hash())
+	assert.Equal(t, block.MakePartSet(types.BlockPartSizeBytes).Header(), cs.LockedBlockParts.Header())
+
+	// the WAL written after the saved state is replayed on top of it
+	cs.Round, cs.Step = 0, cstypes.RoundStepNewHeight
+	wal.WriteSync(timeoutInfo{Height: cs.Height, Round: 0, Step: cstypes.RoundStepNewHeight})
+	srs = cs.restoreRoundState()
+	require.NotNil(t, srs)
+	require.NoError(t, cs.replayWALSince(srs))
+	assert.True(t, cs.Step > cstypes.RoundStepNewHeight, "expected the timeout to be replayed")
+
+	// a block that doesn't extend the last block isn't restored
+	otherBlock, _ := cs.createProposalBlock()
+	require.NotNil(t, otherBlock)
+	otherBlock.LastBlockID = types.BlockID{Hash: []byte("other chain")}
+	cs.LockedBlock = otherBlock
+	require.NoError(t, cs.SaveRoundState())
+	assert.Nil(t, cs.restoreRoundState())
+}
+
+func TestRoundStateFileSavedOnChange(t *testing.T) {
+	thisConfig := ResetConfig("consensus_round_state_saved_on_change_test")
+	defer os.RemoveAll(thisConfig.RootDir)
+
+	cs, _ := randConsensusState(1)
+	cs.config = thisConfig.Consensus
+	wal, err := cs.OpenWAL(cs.config.WalFile())
+	require.NoError(t, err)
+	defer wal.Stop()
+	cs.wal = wal
+
+	saved := func() bool {
+		_, err := os.Stat(cs.roundStateFile())
+		return err == nil
+	}
+
+	// saved on the first step of a height
+	cs.newStep()
+	require.True(t, saved())
+
+	// not saved again if the locked and valid blocks didn't change
+	require.NoError(t, os.Remove(cs.roundStateFile()))
+	cs.newStep()
+	assert.False(t, saved())
+
+	block, _ := cs.createProposalBlock()
+	require.NotNil(t, block)
+	cs.LockedRound, cs.LockedBlock = 0, block
+	cs.newStep()
+	assert.True(t, saved())
+
+	// never saved if disabled
+	require.NoError(t, os.Remove(cs.roundStateFile()))
+	cs.config.SaveRoundState = false
+	cs.ValidRound, cs.ValidBlock = 0, block
+	cs.newStep()
+	assert.False(t, saved())
+}
+
+func TestRoundStateFileCorruption(t *testing.T) {
+	thisConfig := ResetConfig("consensus_round_state_corruption_test")
+	defer os.RemoveAll(thisConfig.RootDir)
+
+	cs, _ := randConsensusState(1)
+	cs.config = thisConfig.Consensus
+	wal, err := cs.OpenWAL(cs.config.WalFile())
+	require.NoError(t, err)
+	defer wal.Stop()
+	cs.wal = wal
+
+	require.NoError(t, cs.SaveRoundState())
+	_, err = loadRoundState(cs.roundStateFile())
+	require.NoError(t, err)
+
+	bz, err := ioutil.ReadFile(cs.roundStateFile())
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xFF
+	require.NoError(t, ioutil.WriteFile(cs.roundStateFile(), bz, 0600))
+
+	_, err = loadRoundState(cs.roundStateFile())
+	_, ok := err.(DataCorruptionError)
+	assert.True(t, ok, "expected DataCorruptionError, got %v", err)
+
+	assert.Nil(t, cs.restoreRoundState())
+}
@@ -99,6 +99,9 @@ type ConsensusState struct {
 	replayMode   bool // so we don't log signing errors during replay
 	doWALCatchup bool // determines if we even try to do the catchup
 
+	// what was last saved to the round state file
+	savedRoundStateKey roundStateKey
+
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
 
@@ -114,6 +117,12 @@ type ConsensusState struct {
 	// state only emits EventNewRoundStep and EventVote
 	evsw tmevents.EventSwitch
 
+	// chooses the proposer of each round
+	proposerSelector ProposerSelector
+
+	// optional application check of proposal blocks before prevoting them
+	proposalValidator ProposalValidator
+
 	// for reporting metrics
 	metrics *Metrics
 }
@@ -145,6 +154,7 @@ func NewConsensusState(
 		wal:              nilWAL{},
 		evpool:           evpool,
 		evsw:             tmevents.NewEventSwitch(),
+		proposerSelector: NewProposerSelector(config.ProposerSelection),
 		metrics:          NopMetrics(),
 	}
 	// set function defaults (may be overwritten before calling Start)
@@ -184,6 +194,39 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *ConsensusState) { cs.metrics = metrics }
 }
 
+// StateProposerSelector sets the algorithm choosing the proposer of each
+// round. Defaults to the one of ConsensusConfig.ProposerSelection.
+func StateProposerSelector(selector ProposerSelector) StateOption {
+	return func(cs *ConsensusState) { cs.proposerSelector = selector }
+}
+
+// ProposalValidator lets the application veto a proposal block before we
+// prevote for it, e.g. after checking it against an external data source.
+//
+// NOTE: ValidateProposal is called synchronously by the consensus state
+// machine, which is blocked until it returns. If it takes longer than
+// timeout_prevote, the node falls behind the other validators and may miss
+// rounds.
+type ProposalValidator interface {
+	// ValidateProposal returns an error if we must prevote nil for the block.
+	// The block has already passed the BlockExecutor's validation.
+	ValidateProposal(block *types.Block) error
+}
+
+// ProposalValidatorFunc is a function implementing ProposalValidator.
+type ProposalValidatorFunc func(block *types.Block) error
+
+// ValidateProposal implements ProposalValidator.
+func (f ProposalValidatorFunc) ValidateProposal(block *types.Block) error {
+	return f(block)
+}
+
+// StateProposalValidator sets a ProposalValidator. A block we are locked on
+// is prevoted regardless, as required for safety.
+func StateProposalValidator(validator ProposalValidator) StateOption {
+	return func(cs *ConsensusState) { cs.proposalValidator = validator }
+}
+
 // String returns a string.
 func (cs *ConsensusState) String() string {
 	// better not to access shared variables
@@ -288,11 +331,28 @@ func (cs *ConsensusState) OnStart() error {
 
 	// we may have lost some votes if the process crashed
 	// reload from consensus log to catchup
+	// only from the saved round state if there is one
+	var srs *savedRoundState
 	if cs.doWALCatchup {
-		if err := cs.catchupReplay(cs.Height); err != nil {
-			cs.Logger.Error("Error on catchup replay. Proceeding to start ConsensusState anyway", "err", err.Error())
-			// NOTE: if we ever do return an error here,
-			// make sure to stop the timeoutTicker
+		srs = cs.restoreRoundState()
+		if srs == nil {
+			if err := cs.catchupReplay(cs.Height); err != nil {
+				cs.Logger.Error("Error on catchup replay. Proceeding to start ConsensusState anyway", "err", err.Error())
+				// NOTE: if we ever do return an error here,
+				// make sure to stop the timeoutTicker
+			}
+		}
+	}
+
+	// resume the restored round before the receiveRoutine starts
+	if srs != nil {
+		if srs.Round > 0 {
+			cs.mtx.Lock()
+			cs.enterNewRound(cs.Height, srs.Round)
+			cs.mtx.Unlock()
+		}
+		if err := cs.replayWALSince(srs); err != nil {
+			cs.Logger.Error("Error replaying WAL after the saved round state. Proceeding to start ConsensusState anyway", "err", err)
 		}
 	}
 
@@ -301,7 +361,9 @@ func (cs *ConsensusState) OnStart() error {
 
 	// schedule the first round!
 	// use GetRoundState so we don't race the receiveRoutine for access
-	cs.scheduleRound0(cs.GetRoundState())
+	if srs == nil || srs.Round == 0 {
+		cs.scheduleRound0(cs.GetRoundState())
+	}
 
 	return nil
 }
@@ -542,6 +604,11 @@ func (cs *ConsensusState) updateToState(state sm.State) {
 func (cs *ConsensusState) newStep() {
 	rs := cs.RoundStateEvent()
 	cs.wal.Write(rs)
+	if cs.config.SaveRoundState && cs.roundStateKey() != cs.savedRoundStateKey {
+		if err := cs.saveRoundState(); err != nil {
+			cs.Logger.Error("Error saving round state", "err", err)
+		}
+	}
 	cs.nSteps++
 	// newStep is called by updateToState in NewConsensusState before the eventBus is set!
 	if cs.eventBus != nil {
@@ -766,7 +833,7 @@ func (cs *ConsensusState) enterNewRound(height int64, round int) {
 	cs.Votes.SetRound(round + 1) // also track next round (round+1) to allow round-skipping
 	cs.triggeredTimeoutPrecommit = false
 
-	cs.eventBus.PublishEventNewRound(cs.NewRoundEvent())
+	cs.eventBus.PublishEventNewRound(cs.NewRoundEvent(cs.proposer()))
 	cs.metrics.Rounds.Set(float64(round))
 
 	// Wait for txs to be available in the mempool
@@ -836,15 +903,20 @@ func (cs *ConsensusState) enterPropose(height int64, round int) {
 	logger.Debug("This node is a validator")
 
 	if cs.isProposer() {
-		logger.Info("enterPropose: Our turn to propose", "proposer", cs.Validators.GetProposer().Address, "privValidator", cs.privValidator)
+		logger.Info("enterPropose: Our turn to propose", "proposer", cs.proposer().Address, "privValidator", cs.privValidator)
 		cs.decideProposal(height, round)
 	} else {
-		logger.Info("enterPropose: Not our turn to propose", "proposer", cs.Validators.GetProposer().Address, "privValidator", cs.privValidator)
+		logger.Info("enterPropose: Not our turn to propose", "proposer", cs.proposer().Address, "privValidator", cs.privValidator)
 	}
 }
 
+// proposer returns the proposer of the current round.
+func (cs *ConsensusState) proposer() *types.Validator {
+	return cs.proposerSelector.SelectProposer(cs.Validators, cs.Height, cs.Round)
+}
+
 func (cs *ConsensusState) isProposer() bool {
-	return bytes.Equal(cs.Validators.GetProposer().Address, cs.privValidator.GetAddress())
+	return bytes.Equal(cs.proposer().Address, cs.privValidator.GetAddress())
 }
 
 func (cs *ConsensusState) defaultDecideProposal(height int64, round int) {
@@ -923,8 +995,8 @@ func (cs *ConsensusState) createProposalBlock() (block *types.Block, blockParts
 	maxGas := cs.state.ConsensusParams.BlockSize.MaxGas
 	// bound evidence to 1/10th of the block
 	evidence := cs.evpool.PendingEvidence(types.MaxEvidenceBytesPerBlock(maxBytes))
-	// Mempool validated transactions
-	txs := cs.mempool.ReapMaxBytesMaxGas(types.MaxDataBytes(
+	// Mempool validated transactions, by priority, up to maxGas in total
+	txs := cs.mempool.ReapMaxBytesMaxGasOrdered(types.MaxDataBytes(
 		maxBytes,
 		cs.state.Validators.Size(),
 		len(evidence),
@@ -986,6 +1058,16 @@ func (cs *ConsensusState) defaultDoPrevote(height int64, round int) {
 		return
 	}
 
+	// Let the application validate the proposal block
+	if cs.proposalValidator != nil {
+		if err := cs.proposalValidator.ValidateProposal(cs.ProposalBlock); err != nil {
+			// ProposalBlock is rejected, prevote nil.
+			logger.Error("enterPrevote: ProposalBlock is rejected by the ProposalValidator", "err", err)
+			cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1311,6 +1393,13 @@ func (cs *ConsensusState) finalizeCommit(height int64) {
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
 
+	// In optimistic mode, the proposer of the next height proposes right away
+	// instead of waiting for timeoutCommit. The other validators prevote as
+	// soon as they have the whole proposal (see addProposalBlockPart).
+	if cs.config.ProposeOptimistic && cs.privValidator != nil && cs.isProposer() {
+		cs.enterNewRound(cs.Height, 0)
+	}
+
 	// By here,
 	// * cs.Height has been increment to height+1
 	// * cs.Step is now cstypes.RoundStepNewHeight
@@ -1378,7 +1467,7 @@ func (cs *ConsensusState) defaultSetProposal(proposal *types.Proposal) error {
 	}
 
 	// Verify signature
-	if !cs.Validators.GetProposer().PubKey.VerifyBytes(proposal.SignBytes(cs.state.ChainID), proposal.Signature) {
+	if !cs.proposer().PubKey.VerifyBytes(proposal.SignBytes(cs.state.ChainID), proposal.Signature) {
 		return ErrInvalidProposalSignature
 	}
 
@@ -1474,11 +1563,7 @@ func (cs *ConsensusState) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, err
 		if err == ErrVoteHeightMismatch {
 			return added, err
 		} else if voteErr, ok := err.(*types.ErrVoteConflictingVotes); ok {
-			if bytes.Equal(vote.ValidatorAddress, cs.privValidator.GetAddress()) {
-				cs.Logger.Error("Found conflicting vote from ourselves. Did you unsafe_reset a validator?", "height", vote.Height, "round", vote.Round, "type", vote.Type)
-				return added, err
-			}
-			cs.evpool.AddEvidence(voteErr.DuplicateVoteEvidence)
+			cs.checkForEquivocation(vote, voteErr)
 			return added, err
 		} else {
 			// Probably an invalid signature / Bad peer.
@@ -1490,6 +1575,20 @@ func (cs *ConsensusState) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, err
 	return added, nil
 }
 
+// checkForEquivocation submits the evidence of a validator signing two
+// different prevotes or precommits in the same round to the evidence pool,
+// unless the validator is us.
+func (cs *ConsensusState) checkForEquivocation(vote *types.Vote, voteErr *types.ErrVoteConflictingVotes) {
+	if cs.privValidator != nil && bytes.Equal(vote.ValidatorAddress, cs.privValidator.GetAddress()) {
+		cs.Logger.Error("Found conflicting vote from ourselves. Did you unsafe_reset a validator?", "height", vote.Height, "round", vote.Round, "type", vote.Type)
+		return
+	}
+	cs.Logger.Info("Found conflicting votes", "validator", vote.ValidatorAddress, "height", vote.Height, "round", vote.Round, "type", vote.Type)
+	if err := cs.evpool.AddEvidence(voteErr.DuplicateVoteEvidence); err != nil {
+		cs.Logger.Error("Failed to add evidence of conflicting votes", "err", err)
+	}
+}
+
 //-----------------------------------------------------------------------------
 
 func (cs *ConsensusState) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
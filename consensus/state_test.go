@@ -3,6 +3,7 @@ package consensus
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	p2pdummy "github.com/tendermint/tendermint/p2p/dummy"
+	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -173,6 +175,25 @@ func TestStateEnterProposeYesPrivValidator(t *testing.T) {
 	ensureNoNewTimeout(timeoutCh, cs.config.TimeoutPropose.Nanoseconds())
 }
 
+// a block rejected by the ProposalValidator is prevoted nil
+func TestStateProposalValidatorRejects(t *testing.T) {
+	cs1, vss := randConsensusState(1)
+	var validated *types.Block
+	StateProposalValidator(ProposalValidatorFunc(func(block *types.Block) error {
+		validated = block
+		return errors.New("oracle says no")
+	}))(cs1)
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	startTestRound(cs1, height, round)
+
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], nil)
+	assert.Equal(t, cs1.GetRoundState().ProposalBlock.Hash(), validated.Hash())
+}
+
 func TestStateBadProposal(t *testing.T) {
 	cs1, vss := randConsensusState(2)
 	height, round := cs1.Height, cs1.Round
@@ -321,6 +342,28 @@ func TestStateFullRound2(t *testing.T) {
 	ensureNewBlock(newBlockCh, height)
 }
 
+// with propose_optimistic, the proposer of the next height doesn't wait for timeoutCommit
+func TestStateProposeOptimistic(t *testing.T) {
+	cs1, _ := randConsensusState(1)
+	consensusConfig := *cs1.config
+	consensusConfig.TimeoutCommit = time.Hour
+	consensusConfig.SkipTimeoutCommit = false
+	consensusConfig.ProposeOptimistic = true
+	cs1.config = &consensusConfig
+	height, round := cs1.Height, cs1.Round
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+
+	// the block is committed and we propose the next one right away
+	ensureNewRound(newRoundCh, height+1, 0)
+	ensureNewProposal(proposalCh, height+1, 0)
+}
+
 //------------------------------------------------------------------------------------------
 // LockSuite
 
@@ -1343,6 +1386,67 @@ func TestStateSlashingPrecommits(t *testing.T) {
 }
 */
 
+type evidenceRecorder struct {
+	sm.MockEvidencePool
+	evidence []types.Evidence
+}
+
+func (er *evidenceRecorder) AddEvidence(ev types.Evidence) error {
+	er.evidence = append(er.evidence, ev)
+	return nil
+}
+
+// conflicting prevotes and precommits are submitted to the evidence pool
+func TestStateEquivocationEvidence(t *testing.T) {
+	cs1, vss := randConsensusState(2)
+	vs2 := vss[1]
+	evpool := &evidenceRecorder{}
+	cs1.evpool = evpool
+
+	header := types.PartSetHeader{Total: 1, Hash: cmn.RandBytes(20)}
+	for _, voteType := range []types.SignedMsgType{types.PrevoteType, types.PrecommitType} {
+		voteA := signVote(vs2, voteType, cmn.RandBytes(20), header)
+		voteB := signVote(vs2, voteType, cmn.RandBytes(20), header)
+
+		added, err := cs1.tryAddVote(voteA, "peer")
+		require.NoError(t, err)
+		require.True(t, added)
+
+		_, err = cs1.tryAddVote(voteB, "peer")
+		require.IsType(t, &types.ErrVoteConflictingVotes{}, err)
+	}
+
+	require.Len(t, evpool.evidence, 2)
+	for i, voteType := range []types.SignedMsgType{types.PrevoteType, types.PrecommitType} {
+		ev, ok := evpool.evidence[i].(*types.DuplicateVoteEvidence)
+		require.True(t, ok)
+		assert.Equal(t, voteType, ev.VoteA.Type)
+		assert.NoError(t, ev.Verify(cs1.state.ChainID, vs2.GetPubKey()))
+	}
+
+	// our own conflicting votes aren't evidence
+	vs1 := vss[0]
+	incrementHeight(vs1)
+	voteA := signVote(vs1, types.PrevoteType, cmn.RandBytes(20), header)
+	voteB := signVote(vs1, types.PrevoteType, cmn.RandBytes(20), header)
+	_, err := cs1.tryAddVote(voteA, "peer")
+	require.NoError(t, err)
+	_, err = cs1.tryAddVote(voteB, "peer")
+	require.IsType(t, &types.ErrVoteConflictingVotes{}, err)
+	assert.Len(t, evpool.evidence, 2)
+
+	// non validators report them too
+	cs1.SetPrivValidator(nil)
+	vs2.Round = 1
+	voteA = signVote(vs2, types.PrevoteType, cmn.RandBytes(20), header)
+	voteB = signVote(vs2, types.PrevoteType, cmn.RandBytes(20), header)
+	_, err = cs1.tryAddVote(voteA, "peer")
+	require.NoError(t, err)
+	_, err = cs1.tryAddVote(voteB, "peer")
+	require.IsType(t, &types.ErrVoteConflictingVotes{}, err)
+	assert.Len(t, evpool.evidence, 3)
+}
+
 //------------------------------------------------------------------------------------------
 // CatchupSuite
 
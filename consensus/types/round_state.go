@@ -112,9 +112,10 @@ func (rs *RoundState) RoundStateSimple() RoundStateSimple {
 	}
 }
 
-// NewRoundEvent returns the RoundState with proposer information as an event.
-func (rs *RoundState) NewRoundEvent() types.EventDataNewRound {
-	addr := rs.Validators.GetProposer().Address
+// NewRoundEvent returns the RoundState with information about the round's
+// proposer as an event.
+func (rs *RoundState) NewRoundEvent(proposer *types.Validator) types.EventDataNewRound {
+	addr := proposer.Address
 	idx, _ := rs.Validators.GetByAddress(addr)
 
 	return types.EventDataNewRound{
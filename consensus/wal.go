@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -205,6 +207,111 @@ func (wal *baseWAL) SearchForEndHeight(height int64, options *WALSearchOptions)
 	return nil, false, nil
 }
 
+// TruncateWAL removes the messages after the EndHeightMessage with the given
+// height from the WAL at walFile, which must not be in use, e.g. to roll back
+// the chain. If the WAL doesn't have this EndHeightMessage, all its messages
+// are replaced with it. It returns the number of bytes removed, and doesn't
+// modify the WAL if dryRun is true.
+func TruncateWAL(walFile string, height int64, dryRun bool) (removed int64, err error) {
+	if _, err := os.Stat(walFile); os.IsNotExist(err) {
+		return 0, nil
+	}
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return 0, err
+	}
+	info := group.ReadGroupInfo()
+	gr, err := group.NewReader(info.MinIndex)
+	if err != nil {
+		group.Close()
+		return 0, err
+	}
+
+	var out *os.File
+	kept := &countingWriter{wr: ioutil.Discard}
+	if !dryRun {
+		out, err = os.Create(walFile + ".truncated")
+		if err != nil {
+			gr.Close()
+			group.Close()
+			return 0, err
+		}
+		kept.wr = out
+	}
+	enc := NewWALEncoder(kept)
+
+	// copy the messages up to the end of height
+	found := false
+	dec := NewWALDecoder(gr)
+	for !found {
+		var msg *TimedWALMessage
+		msg, err = dec.Decode()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err == nil {
+			err = enc.Encode(msg)
+		}
+		if err != nil {
+			break
+		}
+		if m, ok := msg.Msg.(EndHeightMessage); ok && m.Height == height {
+			found = true
+		}
+	}
+	gr.Close()
+	group.Close()
+
+	if err == nil && !found {
+		kept.n = 0
+		if out != nil {
+			if err = out.Truncate(0); err == nil {
+				_, err = out.Seek(0, io.SeekStart)
+			}
+		}
+		if err == nil {
+			err = enc.Encode(&TimedWALMessage{tmtime.Now(), EndHeightMessage{height}})
+		}
+	}
+	if out == nil {
+		return info.TotalSize - kept.n, err
+	}
+	if err == nil {
+		err = out.Sync()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(out.Name()) // nolint: errcheck
+		return 0, err
+	}
+
+	// replace the files of the group with the truncated head
+	for index := info.MinIndex; index < info.MaxIndex; index++ {
+		if err := os.Remove(fmt.Sprintf("%v.%03d", walFile, index)); err != nil {
+			return 0, err
+		}
+	}
+	if err := os.Rename(out.Name(), walFile); err != nil {
+		return 0, err
+	}
+	return info.TotalSize - kept.n, nil
+}
+
+// countingWriter counts the bytes written to wr.
+type countingWriter struct {
+	wr io.Writer
+	n  int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.wr.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 
 // A WALEncoder writes custom-encoded WAL messages to an output stream.
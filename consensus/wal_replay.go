@@ -0,0 +1,261 @@
+package consensus
+
+import (
+	"fmt"
+	"io"
+	"runtime/debug"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+)
+
+// WALReplayer replays the messages of a WAL through a headless
+// ConsensusState (no private validator, no peers, no timeouts other than the
+// ones recorded in the WAL), logging every message and state transition. It
+// is meant for debugging consensus issues offline.
+//
+// Every height is replayed on its own, starting from the state of that
+// height rebuilt from the state and block stores. Blocks are executed against
+// an empty application, and nothing is written to the node's databases, so
+// the node must be stopped but is left untouched.
+type WALReplayer struct {
+	csConfig   *cfg.ConsensusConfig
+	stateDB    dbm.DB
+	blockStore sm.BlockStore
+	genDoc     *types.GenesisDoc
+	logger     log.Logger
+}
+
+// NewWALReplayer returns a WALReplayer reading the state of each height from
+// stateDB and blockStore.
+func NewWALReplayer(csConfig *cfg.ConsensusConfig, stateDB dbm.DB, blockStore sm.BlockStore,
+	genDoc *types.GenesisDoc, logger log.Logger) *WALReplayer {
+	return &WALReplayer{
+		csConfig:   csConfig,
+		stateDB:    stateDB,
+		blockStore: blockStore,
+		genDoc:     genDoc,
+		logger:     logger,
+	}
+}
+
+// Replay replays the messages of heights startHeight to endHeight (inclusive)
+// read from the WAL r. If endHeight is 0, it replays until the end of r. It
+// stops at the first error, or at the first panic, in which case the error
+// includes the message that triggered it.
+func (wr *WALReplayer) Replay(r io.Reader, startHeight, endHeight int64) error {
+	dec := NewWALDecoder(r)
+
+	var (
+		height int64
+		cs     *ConsensusState
+		stop   func()
+	)
+	defer func() {
+		if stop != nil {
+			stop()
+		}
+	}()
+
+	for index := 0; ; index++ {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to decode message #%d: %v", index, err)
+		}
+
+		// #ENDHEIGHT: h-1 starts the messages of height h.
+		if end, ok := msg.Msg.(EndHeightMessage); ok {
+			if stop != nil {
+				stop()
+				cs, stop = nil, nil
+			}
+			height = end.Height + 1
+			if endHeight > 0 && height > endHeight {
+				return nil
+			}
+			if height >= startHeight {
+				wr.logger.Info("Replaying height", "height", height)
+				if cs, stop, err = wr.newConsensusState(height); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if cs == nil {
+			continue
+		}
+
+		if err := wr.replayMessage(cs, index, msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (wr *WALReplayer) replayMessage(cs *ConsensusState, index int, msg *TimedWALMessage) (err error) {
+	before := fmt.Sprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step)
+
+	defer func() {
+		if r := recover(); r != nil {
+			msgJSON, jsonErr := cdc.MarshalJSON(msg)
+			if jsonErr != nil {
+				msgJSON = []byte(fmt.Sprintf("%v", msg.Msg))
+			}
+			err = fmt.Errorf("panic while replaying message #%d %s: %v\n%s", index, msgJSON, r, debug.Stack())
+		}
+	}()
+
+	if err := cs.readReplayMessage(msg, nil); err != nil {
+		return fmt.Errorf("failed to replay message #%d: %v", index, err)
+	}
+
+	if after := fmt.Sprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step); after != before {
+		wr.logger.Info("Transition", "index", index, "from", before, "to", after)
+	}
+	return nil
+}
+
+// newConsensusState returns a ConsensusState for the given height, and a
+// function to stop it.
+func (wr *WALReplayer) newConsensusState(height int64) (*ConsensusState, func(), error) {
+	state, err := wr.stateForHeight(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(abci.NewBaseApplication()))
+	if err := proxyApp.Start(); err != nil {
+		return nil, nil, err
+	}
+	eventBus := types.NewEventBus()
+	if err := eventBus.Start(); err != nil {
+		proxyApp.Stop()
+		return nil, nil, err
+	}
+
+	// Writes go to memory, reads fall back to the node's state.
+	stateDB := &overlayDB{DB: dbm.NewMemDB(), base: wr.stateDB}
+	mempool, evpool := sm.MockMempool{}, sm.MockEvidencePool{}
+	blockExec := sm.NewBlockExecutor(stateDB, wr.logger, proxyApp.Consensus(), mempool, evpool)
+
+	cs := NewConsensusState(wr.csConfig, state, blockExec, readOnlyBlockStore{wr.blockStore}, mempool, evpool)
+	cs.SetLogger(wr.logger)
+	cs.SetEventBus(eventBus)
+	cs.SetTimeoutTicker(nopTimeoutTicker{})
+
+	stop := func() {
+		eventBus.Stop()
+		proxyApp.Stop()
+	}
+	return cs, stop, nil
+}
+
+// stateForHeight returns the state before the block at the given height is
+// committed.
+func (wr *WALReplayer) stateForHeight(height int64) (sm.State, error) {
+	if state := sm.LoadState(wr.stateDB); !state.IsEmpty() && state.LastBlockHeight == height-1 {
+		return state, nil
+	}
+	if height == 1 {
+		state, err := sm.MakeGenesisState(wr.genDoc)
+		if err != nil {
+			return sm.State{}, err
+		}
+		// the app version is only known from the handshake or the blocks
+		if meta := wr.blockStore.LoadBlockMeta(1); meta != nil {
+			state.Version.Consensus = meta.Header.Version
+		}
+		return state, nil
+	}
+
+	// The results of block height-1 are in the header of block height.
+	meta, lastMeta := wr.blockStore.LoadBlockMeta(height), wr.blockStore.LoadBlockMeta(height-1)
+	if meta == nil || lastMeta == nil || wr.blockStore.LoadSeenCommit(height-1) == nil {
+		return sm.State{}, fmt.Errorf("no state for height %d in the block store (height %d)",
+			height, wr.blockStore.Height())
+	}
+
+	lastValidators, err := sm.LoadValidators(wr.stateDB, height-1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	validators, err := sm.LoadValidators(wr.stateDB, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextValidators, err := sm.LoadValidators(wr.stateDB, height+1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	params, err := sm.LoadConsensusParams(wr.stateDB, height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	return sm.State{
+		Version: sm.Version{
+			Consensus: meta.Header.Version,
+			Software:  version.TMCoreSemVer,
+		},
+		ChainID: wr.genDoc.ChainID,
+
+		LastBlockHeight:  height - 1,
+		LastBlockTotalTx: lastMeta.Header.TotalTxs,
+		LastBlockID:      lastMeta.BlockID,
+		LastBlockTime:    lastMeta.Header.Time,
+
+		NextValidators: nextValidators,
+		Validators:     validators,
+		LastValidators: lastValidators,
+		// only used to save the validators, which end up in memory
+		LastHeightValidatorsChanged: height + 1,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: height,
+
+		LastResultsHash: meta.Header.LastResultsHash,
+		AppHash:         meta.Header.AppHash,
+	}, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// overlayDB writes to DB, and reads from base what isn't in DB.
+type overlayDB struct {
+	dbm.DB
+	base dbm.DB
+}
+
+func (db *overlayDB) Get(key []byte) []byte {
+	if value := db.DB.Get(key); value != nil {
+		return value
+	}
+	return db.base.Get(key)
+}
+
+func (db *overlayDB) Has(key []byte) bool {
+	return db.DB.Has(key) || db.base.Has(key)
+}
+
+// readOnlyBlockStore doesn't save blocks.
+type readOnlyBlockStore struct {
+	sm.BlockStore
+}
+
+func (readOnlyBlockStore) SaveBlock(*types.Block, *types.PartSet, *types.Commit) {}
+
+// nopTimeoutTicker ignores timeouts, which are replayed from the WAL instead.
+type nopTimeoutTicker struct{}
+
+func (nopTimeoutTicker) Start() error                   { return nil }
+func (nopTimeoutTicker) Stop() error                    { return nil }
+func (nopTimeoutTicker) Chan() <-chan timeoutInfo       { return nil }
+func (nopTimeoutTicker) ScheduleTimeout(ti timeoutInfo) {}
+func (nopTimeoutTicker) SetLogger(log.Logger)           {}
@@ -0,0 +1,45 @@
+package consensus
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	bc "github.com/tendermint/tendermint/blockchain"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestWALReplayer(t *testing.T) {
+	walBody, err := WALWithNBlocks(2)
+	require.NoError(t, err)
+
+	// the WAL generator uses the test genesis and private validator
+	genDoc, err := types.GenesisDocFromFile(getConfig().GenesisFile())
+	require.NoError(t, err)
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	state.Version.Consensus.App = kvstore.ProtocolVersion
+	stateDB := dbm.NewMemDB()
+	sm.SaveState(stateDB, state)
+	blockStore := bc.NewBlockStore(dbm.NewMemDB())
+
+	var buf bytes.Buffer
+	wr := NewWALReplayer(config.Consensus, stateDB, blockStore, genDoc, log.NewTMLogger(&buf))
+	require.NoError(t, wr.Replay(bytes.NewReader(walBody), 1, 1))
+
+	// the block of height 1 was committed
+	assert.Contains(t, buf.String(), "to=2/0/")
+	// without touching the stores
+	assert.Equal(t, int64(0), blockStore.Height())
+	assert.Equal(t, int64(0), sm.LoadState(stateDB).LastBlockHeight)
+
+	// there is no state for height 2
+	err = wr.Replay(bytes.NewReader(walBody), 2, 0)
+	assert.Error(t, err)
+}
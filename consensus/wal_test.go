@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -89,6 +90,50 @@ func TestWALEncoderDecoder(t *testing.T) {
 	}
 }
 
+func TestTruncateWAL(t *testing.T) {
+	walBody, err := WALWithNBlocks(6)
+	require.NoError(t, err)
+	walFile := tempWALWithData(walBody)
+	defer os.Remove(walFile)
+
+	searchForEndHeight := func(height int64) (found, last bool) {
+		wal, err := NewWAL(walFile)
+		require.NoError(t, err)
+		wal.SetLogger(log.TestingLogger())
+		defer wal.Group().Close()
+		gr, found, err := wal.SearchForEndHeight(height, &WALSearchOptions{})
+		require.NoError(t, err)
+		if !found {
+			return false, false
+		}
+		defer gr.Close()
+		_, err = NewWALDecoder(gr).Decode()
+		return true, err == io.EOF
+	}
+
+	removed, err := TruncateWAL(walFile, 3, true)
+	require.NoError(t, err)
+	assert.True(t, removed > 0)
+	found, last := searchForEndHeight(4)
+	assert.True(t, found && !last, "the WAL isn't modified by a dry run")
+
+	removed2, err := TruncateWAL(walFile, 3, false)
+	require.NoError(t, err)
+	assert.Equal(t, removed, removed2)
+	found, last = searchForEndHeight(3)
+	assert.True(t, found && last, "the WAL ends at height 3")
+	found, _ = searchForEndHeight(4)
+	assert.False(t, found)
+
+	// a WAL without the end of the height only gets it
+	_, err = TruncateWAL(walFile, 5, false)
+	require.NoError(t, err)
+	found, last = searchForEndHeight(5)
+	assert.True(t, found && last)
+	found, _ = searchForEndHeight(3)
+	assert.False(t, found)
+}
+
 func TestWALSearchForEndHeight(t *testing.T) {
 	walBody, err := WALWithNBlocks(6)
 	if err != nil {
@@ -0,0 +1,44 @@
+package evidence
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const MetricsSubsystem = "evidence"
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Total size of the pending evidence in the pool, in bytes.
+	PoolSizeBytes metrics.Gauge
+	// Number of evidence evicted because of max_pool_size.
+	EvictedEvidence metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		PoolSizeBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pool_size_bytes",
+			Help:      "Total size of the pending evidence in bytes.",
+		}, []string{}),
+		EvictedEvidence: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_evidence",
+			Help:      "Number of evidence evicted from the full pool.",
+		}, []string{}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		PoolSizeBytes:   discard.NewGauge(),
+		EvictedEvidence: discard.NewCounter(),
+	}
+}
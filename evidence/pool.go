@@ -26,19 +26,44 @@ type EvidencePool struct {
 	// latest state
 	mtx   sync.Mutex
 	state sm.State
+
+	// total size of the evidence in evidenceList, at most maxSize if positive
+	sizeMtx sync.Mutex
+	size    int64
+	maxSize int64
+
+	metrics *Metrics
 }
 
-func NewEvidencePool(stateDB dbm.DB, evidenceStore *EvidenceStore) *EvidencePool {
+// EvidencePoolOption sets an optional parameter on the EvidencePool.
+type EvidencePoolOption func(*EvidencePool)
+
+func NewEvidencePool(stateDB dbm.DB, evidenceStore *EvidenceStore, options ...EvidencePoolOption) *EvidencePool {
 	evpool := &EvidencePool{
 		stateDB:       stateDB,
 		state:         sm.LoadState(stateDB),
 		logger:        log.NewNopLogger(),
 		evidenceStore: evidenceStore,
 		evidenceList:  clist.New(),
+		metrics:       NopMetrics(),
+	}
+	for _, option := range options {
+		option(evpool)
 	}
 	return evpool
 }
 
+// WithMaxPoolSize limits the total size of the pending evidence to maxBytes:
+// above it, the oldest evidence is evicted. 0 means no limit.
+func WithMaxPoolSize(maxBytes int64) EvidencePoolOption {
+	return func(evpool *EvidencePool) { evpool.maxSize = maxBytes }
+}
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) EvidencePoolOption {
+	return func(evpool *EvidencePool) { evpool.metrics = metrics }
+}
+
 func (evpool *EvidencePool) EvidenceFront() *clist.CElement {
 	return evpool.evidenceList.Front()
 }
@@ -63,6 +88,13 @@ func (evpool *EvidencePool) PendingEvidence(maxBytes int64) []types.Evidence {
 	return evpool.evidenceStore.PendingEvidence(maxBytes)
 }
 
+// SizeBytes returns the total size of the pending evidence in bytes.
+func (evpool *EvidencePool) SizeBytes() int64 {
+	evpool.sizeMtx.Lock()
+	defer evpool.sizeMtx.Unlock()
+	return evpool.size
+}
+
 // State returns the current state of the evpool.
 func (evpool *EvidencePool) State() sm.State {
 	evpool.mtx.Lock()
@@ -112,11 +144,35 @@ func (evpool *EvidencePool) AddEvidence(evidence types.Evidence) (err error) {
 	evpool.logger.Info("Verified new evidence of byzantine behaviour", "evidence", evidence)
 
 	// add evidence to clist
+	evpool.sizeMtx.Lock()
+	defer evpool.sizeMtx.Unlock()
 	evpool.evidenceList.PushBack(evidence)
+	evpool.size += evidenceSize(evidence)
+	// this may evict evidence itself, if it's bigger than the pool
+	evpool.evictEvidence()
+	evpool.metrics.PoolSizeBytes.Set(float64(evpool.size))
 
 	return nil
 }
 
+// evictEvidence removes the oldest evidence, from the clist and the pending
+// evidence of the store, until the pool is no bigger than maxSize.
+// It must be called with sizeMtx held.
+func (evpool *EvidencePool) evictEvidence() {
+	for evpool.maxSize > 0 && evpool.size > evpool.maxSize {
+		e := evpool.evidenceList.Front()
+		ev := e.Value.(types.Evidence)
+		evpool.evidenceList.Remove(e)
+		e.DetachPrev()
+		evpool.size -= evidenceSize(ev)
+		evpool.evidenceStore.MarkEvidenceAsEvicted(ev)
+
+		evpool.logger.Error("Evicted evidence from the full pool", "evidence", ev,
+			"sizeBytes", evpool.size, "max", evpool.maxSize)
+		evpool.metrics.EvictedEvidence.Add(1)
+	}
+}
+
 // MarkEvidenceAsCommitted marks all the evidence as committed and removes it from the queue.
 func (evpool *EvidencePool) MarkEvidenceAsCommitted(height int64, evidence []types.Evidence) {
 	// make a map of committed evidence to remove from the clist
@@ -133,6 +189,9 @@ func (evpool *EvidencePool) MarkEvidenceAsCommitted(height int64, evidence []typ
 }
 
 func (evpool *EvidencePool) removeEvidence(height, maxAge int64, blockEvidenceMap map[string]struct{}) {
+	evpool.sizeMtx.Lock()
+	defer evpool.sizeMtx.Unlock()
+
 	for e := evpool.evidenceList.Front(); e != nil; e = e.Next() {
 		ev := e.Value.(types.Evidence)
 
@@ -144,8 +203,14 @@ func (evpool *EvidencePool) removeEvidence(height, maxAge int64, blockEvidenceMa
 			// remove from clist
 			evpool.evidenceList.Remove(e)
 			e.DetachPrev()
+			evpool.size -= evidenceSize(ev)
 		}
 	}
+	evpool.metrics.PoolSizeBytes.Set(float64(evpool.size))
+}
+
+func evidenceSize(ev types.Evidence) int64 {
+	return int64(len(ev.Bytes()))
 }
 
 func evMapKey(ev types.Evidence) string {
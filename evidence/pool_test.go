@@ -86,3 +86,41 @@ func TestEvidencePool(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 1, pool.evidenceList.Len())
 }
+
+func TestEvidencePoolMaxSize(t *testing.T) {
+	valAddr := []byte("val1")
+	height := int64(5)
+	stateDB := initializeValidatorState(valAddr, height)
+	store := NewEvidenceStore(dbm.NewMemDB())
+	evs := []types.Evidence{
+		types.NewMockGoodEvidence(3, 0, valAddr),
+		types.NewMockGoodEvidence(4, 0, valAddr),
+		types.NewMockGoodEvidence(5, 0, valAddr),
+	}
+	evSize := int64(len(evs[0].Bytes()))
+	pool := NewEvidencePool(stateDB, store, WithMaxPoolSize(2*evSize))
+
+	for _, ev := range evs[:2] {
+		assert.Nil(t, pool.AddEvidence(ev))
+	}
+	assert.Equal(t, 2*evSize, pool.SizeBytes())
+
+	// the oldest evidence is evicted
+	assert.Nil(t, pool.AddEvidence(evs[2]))
+	assert.Equal(t, 2*evSize, pool.SizeBytes())
+	assert.Equal(t, 2, pool.evidenceList.Len())
+	assert.Equal(t, evs[1], pool.EvidenceFront().Value)
+	assert.Equal(t, evs[1:], pool.PendingEvidence(-1))
+	assert.Equal(t, 2, len(pool.PriorityEvidence()))
+
+	// but can be added again, evicting the oldest evidence in turn
+	assert.Nil(t, pool.AddEvidence(evs[0]))
+	assert.Equal(t, 2, pool.evidenceList.Len())
+	assert.Equal(t, evs[2], pool.EvidenceFront().Value)
+	assert.Equal(t, []types.Evidence{evs[0], evs[2]}, pool.PendingEvidence(-1))
+
+	// committed evidence frees space
+	pool.MarkEvidenceAsCommitted(height, evs[2:])
+	assert.Equal(t, evSize, pool.SizeBytes())
+	assert.Equal(t, evs[0], pool.EvidenceFront().Value)
+}
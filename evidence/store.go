@@ -184,6 +184,15 @@ func (store *EvidenceStore) MarkEvidenceAsCommitted(evidence types.Evidence) {
 	store.db.SetSync(lookupKey, cdc.MustMarshalBinaryBare(ei))
 }
 
+// MarkEvidenceAsEvicted removes evidence from pending, outqueue and lookup,
+// as it's evicted from the full pool. It can then be added again, e.g. when
+// a peer sends it once the pool has room for it.
+func (store *EvidenceStore) MarkEvidenceAsEvicted(evidence types.Evidence) {
+	store.MarkEvidenceAsBroadcasted(evidence)
+	store.db.Delete(keyPending(evidence))
+	store.db.Delete(keyLookup(evidence))
+}
+
 //---------------------------------------------------
 // utils
 
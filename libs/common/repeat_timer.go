@@ -188,6 +188,19 @@ func (t *RepeatTimer) Reset() {
 	t.reset()
 }
 
+// Change the period to dur and wait it before firing.
+// Does nothing if the timer was stopped.
+func (t *RepeatTimer) ResetWithDuration(dur time.Duration) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.ticker == nil {
+		return
+	}
+	t.dur = dur
+	t.reset()
+}
+
 //----------------------------------------
 // Misc.
 
@@ -3,7 +3,12 @@ package db
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/errors"
@@ -22,9 +27,11 @@ func init() {
 }
 
 var _ DB = (*GoLevelDB)(nil)
+var _ Backuper = (*GoLevelDB)(nil)
 
 type GoLevelDB struct {
-	db *leveldb.DB
+	db   *leveldb.DB
+	path string
 }
 
 func NewGoLevelDB(name string, dir string) (*GoLevelDB, error) {
@@ -38,7 +45,8 @@ func NewGoLevelDBWithOpts(name string, dir string, o *opt.Options) (*GoLevelDB,
 		return nil, err
 	}
 	database := &GoLevelDB{
-		db: db,
+		db:   db,
+		path: dbPath,
 	}
 	return database, nil
 }
@@ -108,6 +116,111 @@ func (db *GoLevelDB) Close() {
 	db.db.Close()
 }
 
+// Backup implements Backuper, following the live backup pattern of LevelDB:
+// the tables, which are immutable, are hard linked into the copy (or copied,
+// if they can't be, e.g. across filesystems), and the manifest and the
+// journals copied. If the files of the database change meanwhile, e.g. as
+// it's compacted, the backup is made again.
+func (db *GoLevelDB) Backup(dir string) error {
+	dest := filepath.Join(dir, filepath.Base(db.path))
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		return fmt.Errorf("Backup %v already exists", dest)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	tmp := dest + ".tmp"
+	defer os.RemoveAll(tmp) // nolint: errcheck
+	for attempt := 0; attempt < goLevelDBBackupAttempts; attempt++ {
+		consistent, err := db.backupTo(tmp)
+		if err != nil {
+			return err
+		}
+		if consistent {
+			return os.Rename(tmp, dest)
+		}
+	}
+	return fmt.Errorf("The files of %v kept changing during the backup", db.path)
+}
+
+const goLevelDBBackupAttempts = 10
+
+// backupTo copies the files of the database to dir, returning whether they
+// didn't change meanwhile.
+func (db *GoLevelDB) backupTo(dir string) (bool, error) {
+	before, err := goLevelDBFiles(db.path)
+	if err != nil {
+		return false, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return false, err
+	}
+	if err := os.Mkdir(dir, 0700); err != nil {
+		return false, err
+	}
+	for _, name := range before {
+		src, dst := filepath.Join(db.path, name), filepath.Join(dir, name)
+		if strings.HasSuffix(name, ".ldb") || strings.HasSuffix(name, ".sst") {
+			err = os.Link(src, dst)
+			if err != nil && !os.IsNotExist(err) {
+				err = copyFile(src, dst)
+			}
+		} else {
+			err = copyFile(src, dst)
+		}
+		if os.IsNotExist(err) {
+			return false, nil // deleted meanwhile
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	after, err := goLevelDBFiles(db.path)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(before, after), nil
+}
+
+// goLevelDBFiles returns the names of the files of the database at path,
+// without its lock and logs.
+func goLevelDBFiles(path string) ([]string, error) {
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, info := range infos {
+		name := info.Name()
+		if name == "CURRENT" || strings.HasPrefix(name, "MANIFEST-") ||
+			strings.HasSuffix(name, ".log") || strings.HasSuffix(name, ".ldb") || strings.HasSuffix(name, ".sst") {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close() // nolint: errcheck
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close() // nolint: errcheck
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close() // nolint: errcheck
+		return err
+	}
+	return out.Close()
+}
+
 // Implements DB.
 func (db *GoLevelDB) Print() {
 	str, _ := db.db.GetProperty("leveldb.stats")
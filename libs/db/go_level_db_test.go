@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 
 	"github.com/stretchr/testify/require"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -32,6 +35,49 @@ func TestNewGoLevelDB(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestGoLevelDBBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go_level_db_backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	db, err := NewGoLevelDB("test", filepath.Join(dir, "data"))
+	require.Nil(t, err)
+	defer db.Close()
+
+	// some keys in a table, and some only in the journal
+	for i := 0; i < 100; i++ {
+		db.Set(int642Bytes(int64(i)), []byte{byte(i)})
+	}
+	require.Nil(t, db.DB().CompactRange(util.Range{}))
+	for i := 100; i < 200; i++ {
+		db.Set(int642Bytes(int64(i)), []byte{byte(i)})
+	}
+
+	backupDir := filepath.Join(dir, "backup")
+	require.Nil(t, db.Backup(backupDir))
+	require.NotNil(t, db.Backup(backupDir), "the backup exists")
+	db.Set(int642Bytes(200), []byte{200})
+
+	// the tables are hard linked
+	tables, err := filepath.Glob(filepath.Join(backupDir, "test.db", "*.ldb"))
+	require.Nil(t, err)
+	require.NotEmpty(t, tables)
+	for _, table := range tables {
+		src, err := os.Stat(filepath.Join(dir, "data", "test.db", filepath.Base(table)))
+		require.Nil(t, err)
+		dst, err := os.Stat(table)
+		require.Nil(t, err)
+		require.True(t, os.SameFile(src, dst), table)
+	}
+
+	backup, err := NewGoLevelDB("test", backupDir)
+	require.Nil(t, err)
+	defer backup.Close()
+	for i := 0; i < 200; i++ {
+		require.Equal(t, []byte{byte(i)}, backup.Get(int642Bytes(int64(i))), "key %d", i)
+	}
+	require.Nil(t, backup.Get(int642Bytes(200)), "set after the backup")
+}
+
 func BenchmarkRandomReadsWrites(b *testing.B) {
 	b.StopTimer()
 
@@ -68,6 +68,16 @@ type SetDeleter interface {
 	Delete(key []byte)     // CONTRACT: key readonly []byte
 }
 
+//----------------------------------------
+// Backuper
+
+// Backuper is implemented by the DBs which can back themselves up while in
+// use, more cheaply than by copying their keys.
+type Backuper interface {
+	// Backup makes a consistent copy of the DB, named like it, in dir.
+	Backup(dir string) error
+}
+
 //----------------------------------------
 // Iterator
 
@@ -148,9 +148,11 @@ func TestUnsubscribeAll(t *testing.T) {
 	require.NoError(t, err)
 	err = s.Subscribe(ctx, clientID, query.MustParse("tm.events.type='NewBlockHeader'"), ch2)
 	require.NoError(t, err)
+	assert.Equal(t, 2, s.NumClientSubscriptions(clientID))
 
 	err = s.UnsubscribeAll(ctx, clientID)
 	require.NoError(t, err)
+	assert.Equal(t, 0, s.NumClientSubscriptions(clientID))
 
 	err = s.Publish(ctx, "Nick Fury")
 	require.NoError(t, err)
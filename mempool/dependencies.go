@@ -0,0 +1,162 @@
+package mempool
+
+import (
+	"github.com/pkg/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/clist"
+	"github.com/tendermint/tendermint/types"
+)
+
+// DependsOnTagKey is the key of the CheckTx response tags listing the txs a
+// tx depends on, one tag per tx, with the hash of the tx as value (as in
+// TxID, but not hex encoded).
+//
+// When MempoolConfig.EnableDependencyGraph is set, a tx is only reaped after
+// all the txs it depends on, so they end up in the same block or an earlier
+// one. The application should only list txs that are not committed yet, as
+// it is asked again when the tx is rechecked. Txs committed in a block are
+// also dropped from the dependencies of the remaining txs.
+const DependsOnTagKey = "mempool.depends_on"
+
+// ErrTxDependencyCycle is returned when the dependencies of a tx lead back to
+// it. Such a tx could never be reaped.
+var ErrTxDependencyCycle = errors.New("Tx dependencies form a cycle")
+
+// txDependencies returns the hashes of the txs the tx of res depends on, or
+// nil if the dependency graph is disabled.
+func (mem *Mempool) txDependencies(res *abci.ResponseCheckTx) [][]byte {
+	if !mem.config.EnableDependencyGraph {
+		return nil
+	}
+	var deps [][]byte
+	for _, tag := range res.Tags {
+		if string(tag.Key) == DependsOnTagKey {
+			deps = append(deps, tag.Value)
+		}
+	}
+	return deps
+}
+
+func (mem *Mempool) getTxDependencies(memTx *mempoolTx) [][]byte {
+	mem.depsMtx.Lock()
+	defer mem.depsMtx.Unlock()
+	return memTx.dependsOn
+}
+
+func (mem *Mempool) setTxDependencies(memTx *mempoolTx, deps [][]byte) {
+	mem.depsMtx.Lock()
+	defer mem.depsMtx.Unlock()
+	memTx.dependsOn = deps
+}
+
+// checkDependencyCycle returns ErrTxDependencyCycle if tx can be reached from
+// the txs it depends on, through the dependencies of the txs in the mempool.
+func (mem *Mempool) checkDependencyCycle(tx types.Tx, deps [][]byte) error {
+	key := txKey(tx)
+	visited := make(map[string]bool)
+	stack := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		stack = append(stack, string(dep))
+	}
+	for len(stack) > 0 {
+		dep := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if dep == key {
+			return ErrTxDependencyCycle
+		}
+		if visited[dep] {
+			continue
+		}
+		visited[dep] = true
+
+		e, ok := mem.txsMap.Load(dep)
+		if !ok {
+			continue
+		}
+		for _, next := range mem.getTxDependencies(e.(*clist.CElement).Value.(*mempoolTx)) {
+			stack = append(stack, string(next))
+		}
+	}
+	return nil
+}
+
+// dropCommittedDependencies removes the committed txs from the dependencies
+// of the txs in the mempool.
+func (mem *Mempool) dropCommittedDependencies(committed types.Txs) {
+	committedKeys := make(map[string]struct{}, len(committed))
+	for _, tx := range committed {
+		committedKeys[txKey(tx)] = struct{}{}
+	}
+
+	mem.depsMtx.Lock()
+	defer mem.depsMtx.Unlock()
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if len(memTx.dependsOn) == 0 {
+			continue
+		}
+		deps := make([][]byte, 0, len(memTx.dependsOn))
+		for _, dep := range memTx.dependsOn {
+			if _, ok := committedKeys[string(dep)]; !ok {
+				deps = append(deps, dep)
+			}
+		}
+		memTx.dependsOn = deps
+	}
+}
+
+// reapWithDependencies reaps memTxs in order, up to maxBytes and maxGas, but
+// holds each tx back until all the txs it depends on are reaped. Txs depending
+// on txs that are not in memTxs are never reaped.
+func (mem *Mempool) reapWithDependencies(memTxs []*mempoolTx, maxBytes, maxGas int64) types.Txs {
+	var totalBytes int64
+	var totalGas int64
+	txs := make([]types.Tx, 0, len(memTxs))
+	reaped := make(map[string]bool)
+	// txs held back, by the dependency they wait for
+	waiting := make(map[string][]*mempoolTx)
+
+	for _, memTx := range memTxs {
+		queue := []*mempoolTx{memTx}
+		for len(queue) > 0 {
+			memTx := queue[0]
+			queue = queue[1:]
+
+			if dep, ok := mem.unmetDependency(memTx, reaped); ok {
+				waiting[dep] = append(waiting[dep], memTx)
+				continue
+			}
+
+			// Check total size requirement
+			aminoOverhead := types.ComputeAminoOverhead(memTx.tx, 1)
+			if maxBytes > -1 && totalBytes+int64(len(memTx.tx))+aminoOverhead > maxBytes {
+				return txs
+			}
+			totalBytes += int64(len(memTx.tx)) + aminoOverhead
+			// Check total gas requirement
+			if maxGas > -1 && totalGas+memTx.gasWanted > maxGas {
+				return txs
+			}
+			totalGas += memTx.gasWanted
+			txs = append(txs, memTx.tx)
+
+			// the txs waiting for this one may be reaped now
+			key := txKey(memTx.tx)
+			reaped[key] = true
+			queue = append(queue, waiting[key]...)
+			delete(waiting, key)
+		}
+	}
+	return txs
+}
+
+// unmetDependency returns a tx memTx depends on that is not reaped yet.
+func (mem *Mempool) unmetDependency(memTx *mempoolTx, reaped map[string]bool) (string, bool) {
+	for _, dep := range mem.getTxDependencies(memTx) {
+		if !reaped[string(dep)] {
+			return string(dep), true
+		}
+	}
+	return "", false
+}
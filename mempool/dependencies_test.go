@@ -0,0 +1,80 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// dependenciesApp makes txs depend on the txs listed in deps.
+type dependenciesApp struct {
+	*kvstore.KVStoreApplication
+	deps map[string]types.Txs
+}
+
+func (app dependenciesApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+	var tags []cmn.KVPair
+	for _, dep := range app.deps[string(tx)] {
+		tags = append(tags, cmn.KVPair{Key: []byte(DependsOnTagKey), Value: dep.Hash()})
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, GasWanted: 1, Tags: tags}
+}
+
+func TestMempoolDependencyGraph(t *testing.T) {
+	txA, txB, txC, txX := types.Tx("A"), types.Tx("B"), types.Tx("C"), types.Tx("X")
+	app := dependenciesApp{kvstore.NewKVStoreApplication(), map[string]types.Txs{
+		"B": {txA},
+		"C": {txB, txX},
+	}}
+	mempool := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	mempool.config.EnableDependencyGraph = true
+	mempool.config.Recheck = false
+
+	// received before the tx it depends on
+	require.NoError(t, mempool.CheckTx(txB, nil))
+	require.NoError(t, mempool.CheckTx(txC, nil))
+	require.NoError(t, mempool.CheckTx(txA, nil))
+	assert.Equal(t, 3, mempool.Size())
+
+	// txC waits for txX
+	assert.Equal(t, types.Txs{txA, txB}, mempool.ReapMaxBytesMaxGas(-1, -1))
+	assert.Equal(t, types.Txs{txA, txB}, mempool.ReapMaxBytesMaxGasOrdered(-1, -1))
+	assert.Equal(t, types.Txs{txA}, mempool.ReapMaxBytesMaxGas(-1, 1))
+
+	// txX and txA are committed
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{txX, txA}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txB, txC}, mempool.ReapMaxBytesMaxGas(-1, -1))
+}
+
+func TestMempoolDependencyCycle(t *testing.T) {
+	txD, txE, txF := types.Tx("D"), types.Tx("E"), types.Tx("F")
+	app := dependenciesApp{kvstore.NewKVStoreApplication(), map[string]types.Txs{
+		"D": {txE},
+		"E": {txD},
+		"F": {txF},
+	}}
+	mempool := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	mempool.config.EnableDependencyGraph = true
+
+	require.NoError(t, mempool.CheckTx(txD, nil))
+	assert.Equal(t, 1, mempool.Size())
+
+	// txE -> txD -> txE
+	require.NoError(t, mempool.CheckTx(txE, nil))
+	assert.Equal(t, 1, mempool.Size())
+	assert.Equal(t, ErrTxDependencyCycle, mempool.checkDependencyCycle(txE, [][]byte{txD.Hash()}))
+
+	// txF -> txF
+	require.NoError(t, mempool.CheckTx(txF, nil))
+	assert.Equal(t, 1, mempool.Size())
+}
@@ -5,6 +5,7 @@ import (
 	"container/list"
 	"crypto/sha256"
 	"fmt"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -65,6 +66,9 @@ var (
 
 	// ErrMempoolIsFull means Tendermint & an application can't handle that much load
 	ErrMempoolIsFull = errors.New("Mempool is full")
+
+	// ErrTxTooLarge means the tx alone is bigger than max_total_bytes_size
+	ErrTxTooLarge = errors.New("Tx is larger than the mempool")
 )
 
 // ErrPreCheck is returned when tx is too big
@@ -116,6 +120,11 @@ func PostCheckMaxGas(maxGas int64) PostCheckFunc {
 	}
 }
 
+// txKey is the key of a tx in the txsMap: its hash, as in TxID.
+func txKey(tx types.Tx) string {
+	return string(tx.Hash())
+}
+
 // TxID is the hex encoded hash of the bytes as a types.Tx.
 func TxID(tx []byte) string {
 	return fmt.Sprintf("%X", types.Tx(tx).Hash())
@@ -131,6 +140,10 @@ type Mempool struct {
 	proxyMtx             sync.Mutex
 	proxyAppConn         proxy.AppConnMempool
 	txs                  *clist.CList    // concurrent linked-list of good txs
+	txsMap               sync.Map        // txKey -> *clist.CElement, for lookups by tx
+	txsGasWanted         int64           // total gas wanted by txs
+	txsBytes             int64           // total size of txs, in bytes
+	depsMtx              sync.Mutex      // protects the dependencies of txs
 	height               int64           // the last block Update()'d to
 	rechecking           int32           // for re-checking filtered txs on Update()
 	recheckCursor        *clist.CElement // next expected response
@@ -139,6 +152,15 @@ type Mempool struct {
 	txsAvailable         chan struct{} // fires once for each height, when the mempool is not empty
 	preCheck             PreCheckFunc
 	postCheck            PostCheckFunc
+	onTxExpired          func(types.Tx)
+
+	// Parallel rechecks, see WithRecheckConns
+	recheckConns     []proxy.AppConnMempool
+	recheckMtx       sync.Mutex
+	recheckElems     []*clist.CElement       // the txs being rechecked, in order
+	recheckResponses []*abci.ResponseCheckTx // their responses, as they arrive
+	recheckReceived  []int                   // number of responses of each of recheckConns
+	recheckHandled   int                     // number of responses handled, in order
 
 	// Keep a cache of already-seen txs.
 	// This reduces the pressure on the proxyApp.
@@ -147,6 +169,11 @@ type Mempool struct {
 	// A log of mempool txs
 	wal *auto.AutoFile
 
+	// The pending txs, to reload them on restart
+	persistMtx     sync.Mutex
+	persistFile    *os.File
+	persistRecords int // number of records in persistFile
+
 	logger log.Logger
 
 	metrics *Metrics
@@ -209,6 +236,29 @@ func WithPostCheck(f PostCheckFunc) MempoolOption {
 	return func(mem *Mempool) { mem.postCheck = f }
 }
 
+// WithTxExpiredCallback sets a function called with every tx removed from the
+// mempool because it wasn't included in a block within MaxTxTTLBlocks or
+// MaxTxTTLDuration. It's called from Update, so it must not call the mempool.
+func WithTxExpiredCallback(cb func(types.Tx)) MempoolOption {
+	return func(mem *Mempool) { mem.onTxExpired = cb }
+}
+
+// WithRecheckConns sets the connections to the application used to recheck
+// the txs after a block, if there are more than one (see
+// MempoolConfig.RecheckWorkers). The txs are sent to them in turn, and their
+// responses are handled in the order of the txs.
+func WithRecheckConns(conns []proxy.AppConnMempool) MempoolOption {
+	return func(mem *Mempool) {
+		mem.recheckConns = conns
+		for i, conn := range conns {
+			i := i
+			conn.SetResponseCallback(func(req *abci.Request, res *abci.Response) {
+				mem.resCbParallelRecheck(i, req, res)
+			})
+		}
+	}
+}
+
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) MempoolOption {
 	return func(mem *Mempool) { mem.metrics = metrics }
@@ -258,9 +308,27 @@ func (mem *Mempool) Size() int {
 	return mem.txs.Len()
 }
 
+// SizeBytes returns the total size of the transactions in the mempool, in
+// bytes.
+func (mem *Mempool) SizeBytes() int64 {
+	return atomic.LoadInt64(&mem.txsBytes)
+}
+
+// PendingGas returns the total gas wanted by the transactions in the mempool.
+// It is informational: ReapMaxBytesMaxGas already stops at the block's max
+// gas.
+func (mem *Mempool) PendingGas() int64 {
+	return atomic.LoadInt64(&mem.txsGasWanted)
+}
+
 // Flushes the mempool connection to ensure async resCb calls are done e.g.
 // from CheckTx.
 func (mem *Mempool) FlushAppConn() error {
+	for _, conn := range mem.recheckConns {
+		if err := conn.FlushSync(); err != nil {
+			return err
+		}
+	}
 	return mem.proxyAppConn.FlushSync()
 }
 
@@ -272,8 +340,7 @@ func (mem *Mempool) Flush() {
 	mem.cache.Reset()
 
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		mem.txs.Remove(e)
-		e.DetachPrev()
+		mem.removeTx(e)
 	}
 }
 
@@ -294,7 +361,9 @@ func (mem *Mempool) TxsWaitChan() <-chan struct{} {
 // and whether it should be added to the mempool.
 // It blocks if we're waiting on Update() or Reap().
 // cb: A callback from the CheckTx command.
-//     It gets called from another goroutine.
+//
+//	It gets called from another goroutine.
+//
 // CONTRACT: Either cb will get called, or err returned.
 func (mem *Mempool) CheckTx(tx types.Tx, cb func(*abci.Response)) (err error) {
 	mem.proxyMtx.Lock()
@@ -304,6 +373,9 @@ func (mem *Mempool) CheckTx(tx types.Tx, cb func(*abci.Response)) (err error) {
 	if mem.Size() >= mem.config.Size {
 		return ErrMempoolIsFull
 	}
+	if maxBytes := mem.config.MaxTotalBytesSize; maxBytes > 0 && int64(len(tx)) > maxBytes {
+		return ErrTxTooLarge
+	}
 
 	if mem.preCheck != nil {
 		if err := mem.preCheck(tx); err != nil {
@@ -352,23 +424,25 @@ func (mem *Mempool) resCb(req *abci.Request, res *abci.Response) {
 		mem.resCbRecheck(req, res)
 	}
 	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
 }
 
 func (mem *Mempool) resCbNormal(req *abci.Request, res *abci.Response) {
 	switch r := res.Value.(type) {
 	case *abci.Response_CheckTx:
 		tx := req.GetCheckTx().Tx
-		var postCheckErr error
-		if mem.postCheck != nil {
-			postCheckErr = mem.postCheck(tx, r.CheckTx)
-		}
+		postCheckErr := mem.postCheckTx(tx, r.CheckTx)
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
 			memTx := &mempoolTx{
 				height:    mem.height,
 				gasWanted: r.CheckTx.GasWanted,
+				priority:  txPriority(r.CheckTx),
+				dependsOn: mem.txDependencies(r.CheckTx),
+				timestamp: time.Now(),
 				tx:        tx,
 			}
-			mem.txs.PushBack(memTx)
+			mem.addTx(memTx)
+			mem.persistTx(tx)
 			mem.logger.Info("Added good transaction",
 				"tx", TxID(tx),
 				"res", r,
@@ -376,7 +450,11 @@ func (mem *Mempool) resCbNormal(req *abci.Request, res *abci.Response) {
 				"total", mem.Size(),
 			)
 			mem.metrics.TxSizeBytes.Observe(float64(len(tx)))
-			mem.notifyTxsAvailable()
+			// this may evict tx itself, if its priority is the lowest
+			mem.evictTxs()
+			if mem.Size() > 0 {
+				mem.notifyTxsAvailable()
+			}
 		} else {
 			// ignore bad transaction
 			mem.logger.Info("Rejected bad transaction", "tx", TxID(tx), "res", r, "err", postCheckErr)
@@ -392,7 +470,6 @@ func (mem *Mempool) resCbNormal(req *abci.Request, res *abci.Response) {
 func (mem *Mempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 	switch r := res.Value.(type) {
 	case *abci.Response_CheckTx:
-		tx := req.GetCheckTx().Tx
 		memTx := mem.recheckCursor.Value.(*mempoolTx)
 		if !bytes.Equal(req.GetCheckTx().Tx, memTx.tx) {
 			cmn.PanicSanity(
@@ -403,21 +480,7 @@ func (mem *Mempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 				),
 			)
 		}
-		var postCheckErr error
-		if mem.postCheck != nil {
-			postCheckErr = mem.postCheck(tx, r.CheckTx)
-		}
-		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
-			// Good, nothing to do.
-		} else {
-			// Tx became invalidated due to newly committed block.
-			mem.logger.Info("Tx is no longer valid", "tx", TxID(tx), "res", r, "err", postCheckErr)
-			mem.txs.Remove(mem.recheckCursor)
-			mem.recheckCursor.DetachPrev()
-
-			// remove from cache (it might be good later)
-			mem.cache.Remove(tx)
-		}
+		mem.recheckedTx(mem.recheckCursor, r.CheckTx)
 		if mem.recheckCursor == mem.recheckEnd {
 			mem.recheckCursor = nil
 		} else {
@@ -438,6 +501,55 @@ func (mem *Mempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 	}
 }
 
+// recheckedTx updates or removes the tx of elem according to the response of
+// its recheck.
+func (mem *Mempool) recheckedTx(elem *clist.CElement, res *abci.ResponseCheckTx) {
+	memTx := elem.Value.(*mempoolTx)
+	postCheckErr := mem.postCheckTx(memTx.tx, res)
+	if (res.Code == abci.CodeTypeOK) && postCheckErr == nil {
+		// Good, but the application may have changed its priority.
+		memTx.setPriority(txPriority(res))
+		mem.setTxDependencies(memTx, mem.txDependencies(res))
+	} else {
+		// Tx became invalidated due to newly committed block.
+		mem.logger.Info("Tx is no longer valid", "tx", TxID(memTx.tx), "res", res, "err", postCheckErr)
+		mem.removeTx(elem)
+
+		// remove from cache (it might be good later)
+		mem.cache.Remove(memTx.tx)
+	}
+}
+
+// postCheckTx runs the postCheck filter, enforces MaxBlockGas and rejects
+// dependency cycles on the response of CheckTx.
+func (mem *Mempool) postCheckTx(tx types.Tx, res *abci.ResponseCheckTx) error {
+	if mem.postCheck != nil {
+		if err := mem.postCheck(tx, res); err != nil {
+			return err
+		}
+	}
+	if err := PostCheckMaxGas(mem.config.MaxBlockGas)(tx, res); err != nil {
+		return err
+	}
+	return mem.checkDependencyCycle(tx, mem.txDependencies(res))
+}
+
+func (mem *Mempool) addTx(memTx *mempoolTx) {
+	elem := mem.txs.PushBack(memTx)
+	mem.txsMap.Store(txKey(memTx.tx), elem)
+	atomic.AddInt64(&mem.txsGasWanted, memTx.gasWanted)
+	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
+}
+
+func (mem *Mempool) removeTx(elem *clist.CElement) {
+	mem.txs.Remove(elem)
+	elem.DetachPrev()
+	mem.txsMap.Delete(txKey(elem.Value.(*mempoolTx).tx))
+	atomic.AddInt64(&mem.txsGasWanted, -elem.Value.(*mempoolTx).gasWanted)
+	atomic.AddInt64(&mem.txsBytes, -int64(len(elem.Value.(*mempoolTx).tx)))
+	mem.unpersistTx(elem.Value.(*mempoolTx).tx)
+}
+
 // TxsAvailable returns a channel which fires once for every height,
 // and only when transactions are available in the mempool.
 // NOTE: the returned channel may be nil if EnableTxsAvailable was not called.
@@ -472,6 +584,14 @@ func (mem *Mempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 		time.Sleep(time.Millisecond * 10)
 	}
 
+	if mem.config.EnableDependencyGraph {
+		memTxs := make([]*mempoolTx, 0, mem.txs.Len())
+		for e := mem.txs.Front(); e != nil; e = e.Next() {
+			memTxs = append(memTxs, e.Value.(*mempoolTx))
+		}
+		return mem.reapWithDependencies(memTxs, maxBytes, maxGas)
+	}
+
 	var totalBytes int64
 	var totalGas int64
 	// TODO: we will get a performance boost if we have a good estimate of avg
@@ -547,6 +667,20 @@ func (mem *Mempool) Update(
 
 	// Remove committed transactions.
 	txsLeft := mem.removeTxs(txs)
+	if mem.config.EnableDependencyGraph && len(txs) > 0 {
+		mem.dropCommittedDependencies(txs)
+	}
+
+	// Remove expired transactions. Committed ones are removed first, so a tx
+	// included in the last block it was allowed in doesn't expire.
+	if mem.config.MaxTxTTLBlocks > 0 || mem.config.MaxTxTTLDuration > 0 {
+		txsLeft = mem.removeExpiredTxs(height, time.Now())
+	}
+
+	// Drop the records of removed txs from the persistence file.
+	if err := mem.compactPersistence(); err != nil {
+		mem.logger.Error("Error saving txs", "err", err)
+	}
 
 	// Recheck mempool txs if any txs were committed in the block
 	if mem.config.Recheck && len(txsLeft) > 0 {
@@ -559,6 +693,7 @@ func (mem *Mempool) Update(
 
 	// Update metrics
 	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
 
 	return nil
 }
@@ -576,8 +711,7 @@ func (mem *Mempool) removeTxs(txs types.Txs) []types.Tx {
 		// Remove the tx if it's already in a block.
 		if _, ok := txsMap[string(memTx.tx)]; ok {
 			// remove from clist
-			mem.txs.Remove(e)
-			e.DetachPrev()
+			mem.removeTx(e)
 
 			// NOTE: we don't remove committed txs from the cache.
 			continue
@@ -587,11 +721,86 @@ func (mem *Mempool) removeTxs(txs types.Txs) []types.Tx {
 	return txsLeft
 }
 
+// removeExpiredTxs removes the txs validated more than MaxTxTTLBlocks blocks
+// before height, or more than MaxTxTTLDuration before now, and returns the
+// remaining ones.
+func (mem *Mempool) removeExpiredTxs(height int64, now time.Time) []types.Tx {
+	txsLeft := make([]types.Tx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if !mem.isExpired(memTx, height, now) {
+			txsLeft = append(txsLeft, memTx.tx)
+			continue
+		}
+
+		mem.logger.Info("Tx expired", "tx", TxID(memTx.tx), "height", memTx.Height())
+		mem.removeTx(e)
+		mem.metrics.ExpiredTxs.Add(1)
+		// remove from cache, so that it can be submitted again
+		mem.cache.Remove(memTx.tx)
+		if mem.onTxExpired != nil {
+			mem.onTxExpired(memTx.tx)
+		}
+	}
+	return txsLeft
+}
+
+// evictTxs removes txs according to the EvictionPolicy until the total size
+// of the txs is at most MaxTotalBytesSize.
+func (mem *Mempool) evictTxs() {
+	maxBytes := mem.config.MaxTotalBytesSize
+	if maxBytes <= 0 {
+		return
+	}
+	for mem.SizeBytes() > maxBytes {
+		e := mem.evictionCandidate()
+		if e == nil {
+			return
+		}
+		memTx := e.Value.(*mempoolTx)
+		mem.logger.Info("Evicted tx", "tx", TxID(memTx.tx), "sizeBytes", mem.SizeBytes(), "max", maxBytes)
+		mem.removeTx(e)
+		mem.metrics.EvictedTxs.Add(1)
+		// remove from cache, so that it can be submitted again
+		mem.cache.Remove(memTx.tx)
+	}
+}
+
+// evictionCandidate returns the first tx if the EvictionPolicy is "fifo", or
+// the last tx of the lowest priority if it is "priority".
+func (mem *Mempool) evictionCandidate() *clist.CElement {
+	if mem.config.EvictionPolicy != "priority" {
+		return mem.txs.Front()
+	}
+	var lowest *clist.CElement
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		if lowest == nil || e.Value.(*mempoolTx).Priority() <= lowest.Value.(*mempoolTx).Priority() {
+			lowest = e
+		}
+	}
+	return lowest
+}
+
+func (mem *Mempool) isExpired(memTx *mempoolTx, height int64, now time.Time) bool {
+	if mem.config.MaxTxTTLBlocks > 0 && height-memTx.Height() >= mem.config.MaxTxTTLBlocks {
+		return true
+	}
+	if mem.config.MaxTxTTLDuration > 0 && now.Sub(memTx.timestamp) >= mem.config.MaxTxTTLDuration {
+		return true
+	}
+	return false
+}
+
 // NOTE: pass in txs because mem.txs can mutate concurrently.
 func (mem *Mempool) recheckTxs(txs []types.Tx) {
 	if len(txs) == 0 {
 		return
 	}
+	if len(mem.recheckConns) > 1 {
+		mem.recheckTxsParallel()
+		return
+	}
+
 	atomic.StoreInt32(&mem.rechecking, 1)
 	mem.recheckCursor = mem.txs.Front()
 	mem.recheckEnd = mem.txs.Back()
@@ -604,13 +813,88 @@ func (mem *Mempool) recheckTxs(txs []types.Tx) {
 	mem.proxyAppConn.FlushAsync()
 }
 
+// recheckTxsParallel sends the txs to the recheckConns in turn, so each
+// connection rechecks every len(recheckConns)-th tx. The responses are
+// handled by resCbParallelRecheck.
+func (mem *Mempool) recheckTxsParallel() {
+	mem.recheckMtx.Lock()
+	mem.recheckElems = mem.recheckElems[:0]
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		mem.recheckElems = append(mem.recheckElems, e)
+	}
+	elems := mem.recheckElems
+	mem.recheckResponses = make([]*abci.ResponseCheckTx, len(elems))
+	mem.recheckReceived = make([]int, len(mem.recheckConns))
+	mem.recheckHandled = 0
+	mem.recheckMtx.Unlock()
+
+	atomic.StoreInt32(&mem.rechecking, 1)
+	// NOTE: resCbParallelRecheck() may be called concurrently, or before
+	// CheckTxAsync returns.
+	for i, elem := range elems {
+		mem.recheckConns[i%len(mem.recheckConns)].CheckTxAsync(elem.Value.(*mempoolTx).tx)
+	}
+	for _, conn := range mem.recheckConns {
+		conn.FlushAsync()
+	}
+}
+
+// resCbParallelRecheck is the callback of the conn-th of recheckConns. Each
+// connection responds in order, so its n-th response is for the tx
+// conn + n*len(recheckConns). The responses are handled as soon as all the
+// ones of the previous txs have been, as with a serial recheck.
+func (mem *Mempool) resCbParallelRecheck(conn int, req *abci.Request, res *abci.Response) {
+	r, ok := res.Value.(*abci.Response_CheckTx)
+	if !ok {
+		return
+	}
+
+	mem.recheckMtx.Lock()
+	defer mem.recheckMtx.Unlock()
+
+	i := conn + mem.recheckReceived[conn]*len(mem.recheckConns)
+	mem.recheckReceived[conn]++
+	memTx := mem.recheckElems[i].Value.(*mempoolTx)
+	if !bytes.Equal(req.GetCheckTx().Tx, memTx.tx) {
+		cmn.PanicSanity(
+			fmt.Sprintf(
+				"Unexpected tx response from proxy during recheck\nExpected %X, got %X",
+				memTx.tx,
+				req.GetCheckTx().Tx,
+			),
+		)
+	}
+	mem.recheckResponses[i] = r.CheckTx
+
+	for ; mem.recheckHandled < len(mem.recheckElems) && mem.recheckResponses[mem.recheckHandled] != nil; mem.recheckHandled++ {
+		mem.metrics.RecheckTimes.Add(1)
+		mem.recheckedTx(mem.recheckElems[mem.recheckHandled], mem.recheckResponses[mem.recheckHandled])
+	}
+	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+
+	if mem.recheckHandled == len(mem.recheckElems) {
+		// Done!
+		atomic.StoreInt32(&mem.rechecking, 0)
+		mem.logger.Info("Done rechecking txs", "conns", len(mem.recheckConns))
+
+		// incase the recheck removed all txs
+		if mem.Size() > 0 {
+			mem.notifyTxsAvailable()
+		}
+	}
+}
+
 //--------------------------------------------------------------------------------
 
 // mempoolTx is a transaction that successfully ran
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx //
+	height    int64     // height that this tx had been validated in
+	gasWanted int64     // amount of gas this tx states it will require
+	priority  int64     // the higher, the earlier it gets in a block
+	timestamp time.Time // time this tx was added to the mempool
+	dependsOn [][]byte  // hashes of the txs to reap before this one
+	tx        types.Tx  //
 }
 
 // Height returns the height for this transaction
@@ -618,6 +902,15 @@ func (memTx *mempoolTx) Height() int64 {
 	return atomic.LoadInt64(&memTx.height)
 }
 
+// Priority returns the priority of this transaction
+func (memTx *mempoolTx) Priority() int64 {
+	return atomic.LoadInt64(&memTx.priority)
+}
+
+func (memTx *mempoolTx) setPriority(priority int64) {
+	atomic.StoreInt64(&memTx.priority, priority)
+}
+
 //--------------------------------------------------------------------------------
 
 type txCache interface {
@@ -16,14 +16,16 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/abci/example/counter"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
+	"github.com/tendermint/tendermint/abci/server"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
+	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/types"
 )
 
-func newMempoolWithApp(cc proxy.ClientCreator) *Mempool {
+func newMempoolWithApp(cc proxy.ClientCreator, options ...MempoolOption) *Mempool {
 	config := cfg.ResetTestRoot("mempool_test")
 
 	appConnMem, _ := cc.NewABCIClient()
@@ -32,7 +34,7 @@ func newMempoolWithApp(cc proxy.ClientCreator) *Mempool {
 	if err != nil {
 		panic(err)
 	}
-	mempool := NewMempool(config.Mempool, appConnMem, 0)
+	mempool := NewMempool(config.Mempool, appConnMem, 0, options...)
 	mempool.SetLogger(log.TestingLogger())
 	return mempool
 }
@@ -163,6 +165,194 @@ func TestMempoolFilters(t *testing.T) {
 	}
 }
 
+func TestMempoolMaxBlockGas(t *testing.T) {
+	app := kvstore.NewKVStoreApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool := newMempoolWithApp(cc)
+
+	// each tx wants 1 gas
+	mempool.config.MaxBlockGas = 0
+	checkTxs(t, mempool, 10)
+	assert.Equal(t, 0, mempool.Size())
+	assert.EqualValues(t, 0, mempool.PendingGas())
+
+	mempool.config.MaxBlockGas = 1
+	txs := checkTxs(t, mempool, 10)
+	assert.Equal(t, 10, mempool.Size())
+	assert.EqualValues(t, 10, mempool.PendingGas())
+
+	// committed txs no longer count
+	mempool.Lock()
+	err := mempool.Update(1, txs[:4], nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, mempool.PendingGas())
+
+	mempool.Flush()
+	assert.EqualValues(t, 0, mempool.PendingGas())
+}
+
+func TestMempoolTxTTLBlocks(t *testing.T) {
+	app := kvstore.NewKVStoreApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	var expired types.Txs
+	mempool := newMempoolWithApp(cc, WithTxExpiredCallback(func(tx types.Tx) {
+		expired = append(expired, tx)
+	}))
+	mempool.config.MaxTxTTLBlocks = 2
+
+	// validated at height 0, so they may be included in blocks 1 and 2
+	txs := checkTxs(t, mempool, 3)
+	update := func(height int64, committed types.Txs) {
+		mempool.Lock()
+		err := mempool.Update(height, committed, nil, nil)
+		mempool.Unlock()
+		require.NoError(t, err)
+	}
+
+	update(1, types.Txs{txs[0]})
+	assert.Equal(t, 2, mempool.Size())
+	assert.Empty(t, expired)
+
+	// txs[1] is included in the last block it could be, txs[2] expires
+	update(2, types.Txs{txs[1]})
+	assert.Equal(t, 0, mempool.Size())
+	assert.Equal(t, types.Txs{txs[2]}, expired)
+	assert.EqualValues(t, 0, mempool.PendingGas())
+
+	// expired txs can be submitted again, committed ones can't
+	assert.NoError(t, mempool.CheckTx(txs[2], nil))
+	assert.Equal(t, ErrTxInCache, mempool.CheckTx(txs[1], nil))
+	assert.Equal(t, 1, mempool.Size())
+}
+
+func TestMempoolTxTTLDuration(t *testing.T) {
+	app := kvstore.NewKVStoreApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool := newMempoolWithApp(cc)
+	mempool.config.MaxTxTTLDuration = time.Minute
+
+	checkTxs(t, mempool, 1)
+	memTx := mempool.TxsFront().Value.(*mempoolTx)
+	assert.False(t, mempool.isExpired(memTx, 100, memTx.timestamp.Add(time.Minute-1)))
+	assert.True(t, mempool.isExpired(memTx, 100, memTx.timestamp.Add(time.Minute)))
+
+	assert.Len(t, mempool.removeExpiredTxs(1, memTx.timestamp.Add(time.Minute-1)), 1)
+	assert.Len(t, mempool.removeExpiredTxs(1, memTx.timestamp.Add(time.Minute)), 0)
+	assert.Equal(t, 0, mempool.Size())
+}
+
+func TestMempoolEvictionFIFO(t *testing.T) {
+	app := kvstore.NewKVStoreApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool := newMempoolWithApp(cc)
+	mempool.config.MaxTotalBytesSize = 50
+
+	// 20 bytes each
+	txs := checkTxs(t, mempool, 3)
+	assert.Equal(t, txs[1:], mempool.ReapMaxTxs(-1))
+	assert.EqualValues(t, 40, mempool.SizeBytes())
+
+	// evicted txs can be submitted again
+	require.NoError(t, mempool.CheckTx(txs[0], nil))
+	assert.Equal(t, types.Txs{txs[2], txs[0]}, mempool.ReapMaxTxs(-1))
+
+	assert.Equal(t, ErrTxTooLarge, mempool.CheckTx(make([]byte, 51), nil))
+}
+
+func TestMempoolEvictionPriority(t *testing.T) {
+	app := priorityApp{kvstore.NewKVStoreApplication()}
+	cc := proxy.NewLocalClientCreator(app)
+	mempool := newMempoolWithApp(cc)
+	mempool.config.MaxTotalBytesSize = 50
+	mempool.config.EvictionPolicy = "priority"
+
+	newTx := func(priority byte) types.Tx {
+		tx := make(types.Tx, 20)
+		tx[0], tx[1] = priority, byte(mempool.Size())
+		return tx
+	}
+	tx5, tx1, tx3 := newTx(5), newTx(1), newTx(3)
+	for _, tx := range []types.Tx{tx5, tx1, tx3} {
+		require.NoError(t, mempool.CheckTx(tx, nil))
+	}
+	assert.Equal(t, types.Txs{tx5, tx3}, mempool.ReapMaxTxs(-1))
+
+	// a tx of the lowest priority evicts itself
+	require.NoError(t, mempool.CheckTx(newTx(0), nil))
+	assert.Equal(t, types.Txs{tx5, tx3}, mempool.ReapMaxTxs(-1))
+	assert.EqualValues(t, 40, mempool.SizeBytes())
+}
+
+// rejectListedApp rejects the txs in rejected.
+type rejectListedApp struct {
+	*kvstore.KVStoreApplication
+	rejected map[string]bool
+}
+
+func (app rejectListedApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+	if app.rejected[string(tx)] {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK}
+}
+
+func TestMempoolRecheck(t *testing.T) {
+	app := rejectListedApp{kvstore.NewKVStoreApplication(), make(map[string]bool)}
+
+	sockPath := fmt.Sprintf("unix:///tmp/mempool_recheck_%v.sock", cmn.RandStr(6))
+	s := server.NewSocketServer(sockPath, app)
+	s.SetLogger(log.TestingLogger().With("module", "abci-server"))
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	testCases := []struct {
+		name  string
+		cc    proxy.ClientCreator
+		conns int
+	}{
+		{"serial", proxy.NewLocalClientCreator(app), 0},
+		{"parallel", proxy.NewLocalClientCreator(app), 4},
+		{"parallel socket", proxy.NewRemoteClientCreator(sockPath, "socket", true), 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var conns []proxy.AppConnMempool
+			for i := 0; i < tc.conns; i++ {
+				cli, err := tc.cc.NewABCIClient()
+				require.NoError(t, err)
+				cli.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "recheck"))
+				require.NoError(t, cli.Start())
+				defer cli.Stop()
+				conns = append(conns, proxy.NewAppConnMempool(cli))
+			}
+			mempool := newMempoolWithApp(tc.cc, WithRecheckConns(conns))
+
+			txs := checkTxs(t, mempool, 20)
+			require.NoError(t, mempool.FlushAppConn())
+			var valid types.Txs
+			for i, tx := range txs[1:] {
+				if i%3 == 0 {
+					app.rejected[string(tx)] = true
+				} else {
+					valid = append(valid, tx)
+				}
+			}
+
+			mempool.Lock()
+			err := mempool.Update(1, txs[:1], nil, nil)
+			mempool.Unlock()
+			require.NoError(t, err)
+			assert.Equal(t, valid, mempool.ReapMaxTxs(-1))
+
+			// new txs are handled normally afterwards
+			newTxs := checkTxs(t, mempool, 1)
+			require.NoError(t, mempool.FlushAppConn())
+			assert.Equal(t, append(valid, newTxs...), mempool.ReapMaxTxs(-1))
+		})
+	}
+}
+
 func TestMempoolUpdateAddsTxsToCache(t *testing.T) {
 	app := kvstore.NewKVStoreApplication()
 	cc := proxy.NewLocalClientCreator(app)
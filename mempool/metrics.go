@@ -14,12 +14,18 @@ const MetricsSubsytem = "mempool"
 type Metrics struct {
 	// Size of the mempool.
 	Size metrics.Gauge
+	// Total size of the transactions in the mempool, in bytes.
+	SizeBytes metrics.Gauge
 	// Histogram of transaction sizes, in bytes.
 	TxSizeBytes metrics.Histogram
 	// Number of failed transactions.
 	FailedTxs metrics.Counter
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
+	// Number of expired transactions.
+	ExpiredTxs metrics.Counter
+	// Number of transactions evicted because of max_total_bytes_size.
+	EvictedTxs metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -31,6 +37,12 @@ func PrometheusMetrics(namespace string) *Metrics {
 			Name:      "size",
 			Help:      "Size of the mempool (number of uncommitted transactions).",
 		}, []string{}),
+		SizeBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsytem,
+			Name:      "size_bytes",
+			Help:      "Total size of the uncommitted transactions in bytes.",
+		}, []string{}),
 		TxSizeBytes: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsytem,
@@ -50,6 +62,18 @@ func PrometheusMetrics(namespace string) *Metrics {
 			Name:      "recheck_times",
 			Help:      "Number of times transactions are rechecked in the mempool.",
 		}, []string{}),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsytem,
+			Name:      "expired_txs",
+			Help:      "Number of expired transactions.",
+		}, []string{}),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsytem,
+			Name:      "evicted_txs",
+			Help:      "Number of transactions evicted because the mempool was too big.",
+		}, []string{}),
 	}
 }
 
@@ -57,8 +81,11 @@ func PrometheusMetrics(namespace string) *Metrics {
 func NopMetrics() *Metrics {
 	return &Metrics{
 		Size:         discard.NewGauge(),
+		SizeBytes:    discard.NewGauge(),
 		TxSizeBytes:  discard.NewHistogram(),
 		FailedTxs:    discard.NewCounter(),
 		RecheckTimes: discard.NewCounter(),
+		ExpiredTxs:   discard.NewCounter(),
+		EvictedTxs:   discard.NewCounter(),
 	}
 }
@@ -0,0 +1,224 @@
+package mempool
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/types"
+)
+
+/*
+
+When MempoolConfig.PersistenceFile is set, the pending txs are saved to it as
+a log of records, each made of a kind byte and uvarint length prefixed data:
+
+1. on start, the saved txs are reloaded through CheckTx, so that the ones
+   the application no longer accepts are dropped;
+2. every tx added to the mempool is appended to the file as an add record
+   holding the tx, so that it survives a crash;
+3. every tx removed from the mempool is appended to the file as a remove
+   record holding the tx hash;
+4. after a block, the file is rewritten with the remaining txs only if the
+   records outnumber them by persistCompactRatio, so that it doesn't grow
+   forever;
+5. on stop, the file is rewritten with all the pending txs.
+
+Appended records are not synced, so after a crash the file may lack the last
+records written: added txs are then lost, and removed txs are reloaded unless
+the application rejects them in CheckTx.
+
+*/
+
+const (
+	persistRecordAdd    = byte(0x01)
+	persistRecordRemove = byte(0x02)
+
+	// the file is compacted once it holds persistCompactRatio times more
+	// records than pending txs, and at least persistCompactMinRecords.
+	persistCompactRatio      = 2
+	persistCompactMinRecords = 1000
+)
+
+// InitPersistence reloads the txs saved in the persistence file, and starts
+// saving pending txs to it. The application must be in the state of the
+// mempool's height.
+//
+// *not thread safe*
+func (mem *Mempool) InitPersistence() error {
+	txs, err := readPersistedTxs(mem.config.PersistencePath())
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "Error reading Mempool persistence file")
+	}
+	for _, tx := range txs {
+		if err := mem.CheckTx(tx, nil); err != nil {
+			mem.logger.Info("Dropped saved tx", "tx", TxID(tx), "err", err)
+		}
+	}
+	// wait for the txs to be checked
+	if err := mem.FlushAppConn(); err != nil {
+		return err
+	}
+	mem.logger.Info("Reloaded saved txs", "saved", len(txs), "total", mem.Size())
+
+	return mem.savePersistence()
+}
+
+// ClosePersistence saves all the pending txs to the persistence file, and
+// closes it. Txs added afterwards are not saved.
+func (mem *Mempool) ClosePersistence() error {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+
+	err := mem.savePersistence()
+
+	mem.persistMtx.Lock()
+	defer mem.persistMtx.Unlock()
+	if mem.persistFile != nil {
+		if cerr := mem.persistFile.Close(); err == nil {
+			err = cerr
+		}
+		mem.persistFile = nil
+	}
+	return err
+}
+
+// savePersistence rewrites the persistence file with the pending txs, and
+// opens it to append new records.
+func (mem *Mempool) savePersistence() error {
+	mem.persistMtx.Lock()
+	defer mem.persistMtx.Unlock()
+
+	if mem.persistFile != nil {
+		if err := mem.persistFile.Close(); err != nil {
+			mem.logger.Error("Error closing Mempool persistence file", "err", err)
+		}
+		mem.persistFile = nil
+	}
+
+	buf := new(bytes.Buffer)
+	records := 0
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		writePersistRecord(buf, persistRecordAdd, e.Value.(*mempoolTx).tx)
+		records++
+	}
+
+	path := mem.config.PersistencePath()
+	if err := cmn.EnsureDir(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrap(err, "Error ensuring Mempool persistence dir")
+	}
+	if err := cmn.WriteFileAtomic(path, buf.Bytes(), 0600); err != nil {
+		return errors.Wrap(err, "Error writing Mempool persistence file")
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "Error opening Mempool persistence file")
+	}
+	mem.persistFile = f
+	mem.persistRecords = records
+	return nil
+}
+
+// compactPersistence rewrites the persistence file if it's open and holds
+// too many records of removed txs.
+func (mem *Mempool) compactPersistence() error {
+	mem.persistMtx.Lock()
+	compact := mem.persistFile != nil &&
+		mem.persistRecords >= persistCompactMinRecords &&
+		mem.persistRecords > persistCompactRatio*mem.Size()
+	mem.persistMtx.Unlock()
+
+	if !compact {
+		return nil
+	}
+	return mem.savePersistence()
+}
+
+// persistTx appends an add record of tx to the persistence file, if it's open.
+func (mem *Mempool) persistTx(tx types.Tx) {
+	mem.appendPersistRecord(persistRecordAdd, tx)
+}
+
+// unpersistTx appends a remove record of tx to the persistence file, if it's
+// open.
+func (mem *Mempool) unpersistTx(tx types.Tx) {
+	mem.appendPersistRecord(persistRecordRemove, tx.Hash())
+}
+
+func (mem *Mempool) appendPersistRecord(kind byte, data []byte) {
+	mem.persistMtx.Lock()
+	defer mem.persistMtx.Unlock()
+
+	if mem.persistFile == nil {
+		return
+	}
+	buf := new(bytes.Buffer)
+	writePersistRecord(buf, kind, data)
+	if _, err := mem.persistFile.Write(buf.Bytes()); err != nil {
+		mem.logger.Error("Error saving Mempool persistence record", "err", err)
+		return
+	}
+	mem.persistRecords++
+}
+
+func writePersistRecord(buf *bytes.Buffer, kind byte, data []byte) {
+	var lenBz [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBz[:], uint64(len(data)))
+	buf.WriteByte(kind)
+	buf.Write(lenBz[:n])
+	buf.Write(data)
+}
+
+// readPersistedTxs returns the txs added and not removed by the records saved
+// in file, in the order they were added. A truncated record at the end of the
+// file, left by a crash while appending it, is ignored.
+func readPersistedTxs(file string) ([]types.Tx, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		txs   []types.Tx
+		index = make(map[string]int) // tx hash -> position in txs
+	)
+	for len(bz) > 1 {
+		kind := bz[0]
+		dataLen, n := binary.Uvarint(bz[1:])
+		if n <= 0 || uint64(len(bz)-1-n) < dataLen {
+			break
+		}
+		data := bz[1+n : 1+n+int(dataLen)]
+		bz = bz[1+n+int(dataLen):]
+
+		switch kind {
+		case persistRecordAdd:
+			tx := types.Tx(data)
+			if _, ok := index[string(tx.Hash())]; !ok {
+				index[string(tx.Hash())] = len(txs)
+				txs = append(txs, tx)
+			}
+		case persistRecordRemove:
+			if i, ok := index[string(data)]; ok {
+				txs[i] = nil
+				delete(index, string(data))
+			}
+		default:
+			return nil, fmt.Errorf("Unknown Mempool persistence record %X", kind)
+		}
+	}
+
+	pending := make([]types.Tx, 0, len(index))
+	for _, tx := range txs {
+		if tx != nil {
+			pending = append(pending, tx)
+		}
+	}
+	return pending, nil
+}
@@ -0,0 +1,104 @@
+package mempool
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// rejectZeroApp rejects the txs starting with 0.
+type rejectZeroApp struct {
+	*kvstore.KVStoreApplication
+}
+
+func (app rejectZeroApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+	if tx[0] == 0 {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK}
+}
+
+func newPersistentMempool(t *testing.T, app abci.Application, file string) *Mempool {
+	mempool := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	mempool.config.PersistenceFile = file
+	require.NoError(t, mempool.InitPersistence())
+	return mempool
+}
+
+func TestMempoolPersistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool_persistence_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "mempool.txs")
+
+	// nothing saved yet
+	mempool := newPersistentMempool(t, kvstore.NewKVStoreApplication(), file)
+	assert.Equal(t, 0, mempool.Size())
+
+	txs := types.Txs{{1}, {0, 1}, {2, 2}, {3, 3, 3}}
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(tx, nil))
+	}
+	require.NoError(t, mempool.ClosePersistence())
+
+	// txs the application now rejects are dropped
+	mempool = newPersistentMempool(t, rejectZeroApp{kvstore.NewKVStoreApplication()}, file)
+	assert.Equal(t, types.Txs{{1}, {2, 2}, {3, 3, 3}}, mempool.ReapMaxTxs(-1))
+
+	// committed txs are dropped after the block
+	mempool.Lock()
+	err = mempool.Update(1, types.Txs{{2, 2}}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	saved, err := readPersistedTxs(file)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Tx{{1}, {3, 3, 3}}, saved)
+
+	// new txs are appended, so they survive a crash
+	require.NoError(t, mempool.CheckTx(types.Tx{4}, nil))
+	mempool = newPersistentMempool(t, kvstore.NewKVStoreApplication(), file)
+	assert.Equal(t, types.Txs{{1}, {3, 3, 3}, {4}}, mempool.ReapMaxTxs(-1))
+	require.NoError(t, mempool.ClosePersistence())
+}
+
+func TestReadPersistedTxs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool_persistence_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "mempool.txs")
+
+	buf := new(bytes.Buffer)
+	writePersistRecord(buf, persistRecordAdd, types.Tx{1})
+	writePersistRecord(buf, persistRecordAdd, types.Tx{2})
+	writePersistRecord(buf, persistRecordAdd, types.Tx{3})
+	writePersistRecord(buf, persistRecordRemove, types.Tx{2}.Hash())
+	writePersistRecord(buf, persistRecordAdd, types.Tx{2})
+	writePersistRecord(buf, persistRecordRemove, types.Tx{1}.Hash())
+	require.NoError(t, ioutil.WriteFile(file, buf.Bytes(), 0600))
+	txs, err := readPersistedTxs(file)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Tx{{3}, {2}}, txs)
+}
+
+func TestReadPersistedTxsTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool_persistence_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "mempool.txs")
+
+	// {1, 2}, then a tx of 3 bytes cut short
+	require.NoError(t, ioutil.WriteFile(file, []byte{1, 2, 1, 2, 1, 3, 1, 2}, 0600))
+	txs, err := readPersistedTxs(file)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Tx{{1, 2}}, txs)
+}
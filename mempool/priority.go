@@ -0,0 +1,131 @@
+package mempool
+
+import (
+	"container/heap"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/clist"
+	"github.com/tendermint/tendermint/types"
+)
+
+// PriorityTagKey is the key of the CheckTx response tag holding the priority
+// of a tx, as a decimal int64. Txs without it have priority 0.
+//
+// Applications charging fees typically set it to the fee paid per unit of
+// gas, so that a flood of zero-fee txs can't delay the ones paying for their
+// inclusion. Txs of the same priority are reaped in the order they were
+// received.
+const PriorityTagKey = "mempool.priority"
+
+// txPriority returns the priority set by the application in res.
+func txPriority(res *abci.ResponseCheckTx) int64 {
+	for _, tag := range res.Tags {
+		if string(tag.Key) != PriorityTagKey {
+			continue
+		}
+		priority, err := strconv.ParseInt(string(tag.Value), 10, 64)
+		if err != nil {
+			return 0
+		}
+		return priority
+	}
+	return 0
+}
+
+// SetTxPriority sets the priority of tx, if it is in the mempool. It
+// overrides the priority set by the application in the CheckTx response until
+// tx is rechecked.
+func (mem *Mempool) SetTxPriority(tx types.Tx, priority int64) {
+	if e, ok := mem.txsMap.Load(txKey(tx)); ok {
+		e.(*clist.CElement).Value.(*mempoolTx).setPriority(priority)
+	}
+}
+
+// ReapMaxBytesMaxGasOrdered is like ReapMaxBytesMaxGas, but reaps txs by
+// decreasing priority instead of in the order they were received. It stops
+// at the first tx that doesn't fit, so it never skips a tx of a higher
+// priority for one of a lower priority.
+func (mem *Mempool) ReapMaxBytesMaxGasOrdered(maxBytes, maxGas int64) types.Txs {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+
+	for atomic.LoadInt32(&mem.rechecking) > 0 {
+		// TODO: Something better?
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	queue := make(txPriorityQueue, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		queue = append(queue, prioritizedTx{memTx, memTx.Priority(), len(queue)})
+	}
+	heap.Init(&queue)
+
+	if mem.config.EnableDependencyGraph {
+		memTxs := make([]*mempoolTx, 0, len(queue))
+		for queue.Len() > 0 {
+			memTxs = append(memTxs, heap.Pop(&queue).(prioritizedTx).memTx)
+		}
+		return mem.reapWithDependencies(memTxs, maxBytes, maxGas)
+	}
+
+	var totalBytes int64
+	var totalGas int64
+	txs := make([]types.Tx, 0, len(queue))
+	for queue.Len() > 0 {
+		memTx := heap.Pop(&queue).(prioritizedTx).memTx
+		// Check total size requirement
+		aminoOverhead := types.ComputeAminoOverhead(memTx.tx, 1)
+		if maxBytes > -1 && totalBytes+int64(len(memTx.tx))+aminoOverhead > maxBytes {
+			return txs
+		}
+		totalBytes += int64(len(memTx.tx)) + aminoOverhead
+		// Check total gas requirement
+		if maxGas > -1 && totalGas+memTx.gasWanted > maxGas {
+			return txs
+		}
+		totalGas += memTx.gasWanted
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
+
+//--------------------------------------------------------------------------------
+
+type prioritizedTx struct {
+	memTx    *mempoolTx
+	priority int64 // snapshot, as it may change while reaping
+	seq      int   // position in the mempool
+}
+
+// txPriorityQueue is a max-heap of txs by priority, then by position in the
+// mempool.
+type txPriorityQueue []prioritizedTx
+
+var _ heap.Interface = (*txPriorityQueue)(nil)
+
+func (pq txPriorityQueue) Len() int { return len(pq) }
+
+func (pq txPriorityQueue) Less(i, j int) bool {
+	if pq[i].priority != pq[j].priority {
+		return pq[i].priority > pq[j].priority
+	}
+	return pq[i].seq < pq[j].seq
+}
+
+func (pq txPriorityQueue) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *txPriorityQueue) Push(x interface{}) {
+	*pq = append(*pq, x.(prioritizedTx))
+}
+
+func (pq *txPriorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	x := old[n-1]
+	*pq = old[:n-1]
+	return x
+}
@@ -0,0 +1,79 @@
+package mempool
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// priorityApp gives each tx the priority of its first byte.
+type priorityApp struct {
+	*kvstore.KVStoreApplication
+}
+
+func (app priorityApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+	return abci.ResponseCheckTx{
+		Code:      abci.CodeTypeOK,
+		GasWanted: 1,
+		Tags: []cmn.KVPair{
+			{Key: []byte(PriorityTagKey), Value: []byte(strconv.Itoa(int(tx[0])))},
+		},
+	}
+}
+
+func TestReapMaxBytesMaxGasOrdered(t *testing.T) {
+	app := priorityApp{kvstore.NewKVStoreApplication()}
+	cc := proxy.NewLocalClientCreator(app)
+	mempool := newMempoolWithApp(cc)
+
+	txs := types.Txs{{1, 0}, {3, 0}, {2, 0}, {3, 1}, {0, 0}}
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(tx, nil))
+	}
+
+	// received order
+	assert.Equal(t, txs, mempool.ReapMaxBytesMaxGas(-1, -1))
+	// by priority, then received order
+	assert.Equal(t, types.Txs{{3, 0}, {3, 1}, {2, 0}, {1, 0}, {0, 0}},
+		mempool.ReapMaxBytesMaxGasOrdered(-1, -1))
+	assert.Equal(t, types.Txs{{3, 0}, {3, 1}}, mempool.ReapMaxBytesMaxGasOrdered(-1, 2))
+	assert.Equal(t, types.Txs{{3, 0}}, mempool.ReapMaxBytesMaxGasOrdered(4, -1))
+
+	mempool.SetTxPriority(types.Tx{0, 0}, 10)
+	mempool.SetTxPriority(types.Tx{9, 9}, 10) // not in the mempool
+	assert.Equal(t, types.Txs{{0, 0}, {3, 0}, {3, 1}, {2, 0}, {1, 0}},
+		mempool.ReapMaxBytesMaxGasOrdered(-1, -1))
+
+	// rechecking restores the priority set by the application
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{{3, 0}}, nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{{3, 1}, {2, 0}, {1, 0}, {0, 0}},
+		mempool.ReapMaxBytesMaxGasOrdered(-1, -1))
+}
+
+func TestTxPriority(t *testing.T) {
+	testCases := []struct {
+		tags     []cmn.KVPair
+		priority int64
+	}{
+		{nil, 0},
+		{[]cmn.KVPair{{Key: []byte("other"), Value: []byte("5")}}, 0},
+		{[]cmn.KVPair{{Key: []byte(PriorityTagKey), Value: []byte("5")}}, 5},
+		{[]cmn.KVPair{{Key: []byte(PriorityTagKey), Value: []byte("-5")}}, -5},
+		{[]cmn.KVPair{{Key: []byte(PriorityTagKey), Value: []byte("five")}}, 0},
+	}
+	for i, tc := range testCases {
+		res := &abci.ResponseCheckTx{Tags: tc.tags}
+		assert.Equal(t, tc.priority, txPriority(res), "#%d", i)
+	}
+}
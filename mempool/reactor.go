@@ -88,6 +88,10 @@ func (memR *MempoolReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 
 	switch msg := msg.(type) {
 	case *TxMessage:
+		if memR.Switch.IsDuplicate(src, msg.Tx.Hash()) {
+			memR.Logger.Debug("Dropping duplicate tx", "tx", TxID(msg.Tx), "src", src)
+			return
+		}
 		err := memR.Mempool.CheckTx(msg.Tx, nil)
 		if err != nil {
 			memR.Logger.Info("Could not check tx", "tx", TxID(msg.Tx), "err", err)
@@ -28,6 +28,7 @@ import (
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/p2p/upnp"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	rpccore "github.com/tendermint/tendermint/rpc/core"
@@ -97,18 +98,30 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func() (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// Option sets a parameter for the node.
+type Option func(*Node)
+
+// ConsensusProposalValidator sets the ProposalValidator consensus consults
+// before prevoting a proposal block. See consensus.StateProposalValidator.
+func ConsensusProposalValidator(validator cs.ProposalValidator) Option {
+	return func(n *Node) {
+		cs.StateProposalValidator(validator)(n.consensusState)
+	}
+}
+
+// MetricsProvider returns a consensus, p2p, mempool, state and evidence Metrics.
+type MetricsProvider func() (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *evidence.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func() (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func() (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *evidence.Metrics) {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace), p2p.PrometheusMetrics(config.Namespace),
-				mempl.PrometheusMetrics(config.Namespace), sm.PrometheusMetrics(config.Namespace)
+				mempl.PrometheusMetrics(config.Namespace), sm.PrometheusMetrics(config.Namespace),
+				evidence.PrometheusMetrics(config.Namespace)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), evidence.NopMetrics()
 	}
 }
 
@@ -125,6 +138,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
+	portMapper  *upnp.PortMapper // nil unless UPnP is enabled
 	transport   *p2p.MultiplexTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
@@ -145,6 +159,7 @@ type Node struct {
 	rpcListeners     []net.Listener         // rpc servers
 	txIndexer        txindex.TxIndexer
 	indexerService   *txindex.IndexerService
+	pruner           *sm.Pruner // nil if no block is pruned
 	prometheusSrv    *http.Server
 }
 
@@ -156,14 +171,17 @@ func NewNode(config *cfg.Config,
 	genesisDocProvider GenesisDocProvider,
 	dbProvider DBProvider,
 	metricsProvider MetricsProvider,
-	logger log.Logger) (*Node, error) {
+	logger log.Logger,
+	options ...Option) (*Node, error) {
 
 	// Get BlockStore
 	blockStoreDB, err := dbProvider(&DBContext{"blockstore", config})
 	if err != nil {
 		return nil, err
 	}
-	blockStore := bc.NewBlockStore(blockStoreDB)
+	blockStore := bc.NewBlockStore(blockStoreDB,
+		bc.BlockStoreWithReadAhead(config.Store.ReadAheadBlocks),
+		bc.BlockStoreWithWriteBatch(config.Store.WriteBatch))
 
 	// Get State
 	stateDB, err := dbProvider(&DBContext{"state", config})
@@ -252,9 +270,13 @@ func NewNode(config *cfg.Config,
 		consensusLogger.Info("This node is not a validator", "addr", privValidator.GetAddress(), "pubKey", privValidator.GetPubKey())
 	}
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider()
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, evidenceMetrics := metricsProvider()
 
 	// Make MempoolReactor
+	recheckConns, err := createAndStartRecheckConns(clientCreator, config.Mempool, logger)
+	if err != nil {
+		return nil, err
+	}
 	mempool := mempl.NewMempool(
 		config.Mempool,
 		proxyApp.Mempool(),
@@ -262,6 +284,7 @@ func NewNode(config *cfg.Config,
 		mempl.WithMetrics(memplMetrics),
 		mempl.WithPreCheck(sm.TxPreCheck(state)),
 		mempl.WithPostCheck(sm.TxPostCheck(state)),
+		mempl.WithRecheckConns(recheckConns),
 	)
 	mempoolLogger := logger.With("module", "mempool")
 	mempool.SetLogger(mempoolLogger)
@@ -274,6 +297,11 @@ func NewNode(config *cfg.Config,
 	if config.Consensus.WaitForTxs() {
 		mempool.EnableTxsAvailable()
 	}
+	if config.Mempool.PersistenceEnabled() {
+		if err := mempool.InitPersistence(); err != nil {
+			return nil, err
+		}
+	}
 
 	// Make Evidence Reactor
 	evidenceDB, err := dbProvider(&DBContext{"evidence", config})
@@ -282,7 +310,9 @@ func NewNode(config *cfg.Config,
 	}
 	evidenceLogger := logger.With("module", "evidence")
 	evidenceStore := evidence.NewEvidenceStore(evidenceDB)
-	evidencePool := evidence.NewEvidencePool(stateDB, evidenceStore)
+	evidencePool := evidence.NewEvidencePool(stateDB, evidenceStore,
+		evidence.WithMaxPoolSize(int64(config.Evidence.MaxPoolSize)),
+		evidence.WithMetrics(evidenceMetrics))
 	evidencePool.SetLogger(evidenceLogger)
 	evidenceReactor := evidence.NewEvidenceReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
@@ -316,7 +346,11 @@ func NewNode(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewConsensusReactor(consensusState, fastSync, cs.ReactorMetrics(csMetrics))
+	reactorOptions := []cs.ReactorOption{cs.ReactorMetrics(csMetrics)}
+	if config.Consensus.VoteDedupCapacity > 0 {
+		reactorOptions = append(reactorOptions, cs.ReactorVoteDedup(config.Consensus.VoteDedupCapacity))
+	}
+	consensusReactor := cs.NewConsensusReactor(consensusState, fastSync, reactorOptions...)
 	consensusReactor.SetLogger(consensusLogger)
 
 	eventBus := types.NewEventBus()
@@ -348,6 +382,32 @@ func NewNode(config *cfg.Config,
 	indexerService := txindex.NewIndexerService(txIndexer, eventBus)
 	indexerService.SetLogger(logger.With("module", "txindex"))
 
+	// Prune the stores according to the retention policy
+	var pruner *sm.Pruner
+	switch config.Store.PruningStrategy {
+	case "everything":
+		pruner = sm.NewPruner(1, 0, stateDB, blockStore, eventBus, sm.PrunerWithMetrics(smMetrics))
+	case "custom":
+		pruner = sm.NewPruner(config.Store.PruningKeepRecent, config.Store.PruningKeepEvery,
+			stateDB, blockStore, eventBus, sm.PrunerWithMetrics(smMetrics))
+	}
+	if pruner != nil {
+		pruner.SetLogger(logger.With("module", "pruner"))
+	}
+
+	// Open the P2P port on the router, so peers can dial in to nodes behind a
+	// NAT, and advertise the router's external address.
+	var portMapper *upnp.PortMapper
+	if config.P2P.UPNP {
+		portMapper, err = createAndStartPortMapper(config.P2P, logger.With("module", "upnp"))
+		if err != nil {
+			logger.Error("Failed to map P2P port on router", "err", err)
+		} else {
+			ip, port := portMapper.ExternalAddress()
+			config.P2P.ExternalAddress = p2p.NewNetAddressIPPort(ip, uint16(port)).String()
+		}
+	}
+
 	var (
 		p2pLogger = logger.With("module", "p2p")
 		nodeInfo  = makeNodeInfo(
@@ -366,11 +426,17 @@ func NewNode(config *cfg.Config,
 	// Setup Transport.
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		transport   *p2p.MultiplexTransport
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
 
+	if config.P2P.Protocol == p2p.ProtocolQUIC {
+		transport = p2p.NewQUICTransport(nodeInfo, *nodeKey, mConnConfig)
+	} else {
+		transport = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+	}
+
 	if !config.P2P.AllowDuplicateIP {
 		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
 	}
@@ -416,14 +482,23 @@ func NewNode(config *cfg.Config,
 	}
 
 	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
+	p2p.MultiplexTransportCompressThreshold(config.P2P.CompressThreshold)(transport)
 
 	// Setup Switch.
-	sw := p2p.NewSwitch(
-		config.P2P,
-		transport,
+	swOptions := []p2p.SwitchOption{
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
-	)
+		p2p.SwitchPeerScoreStore(p2p.NewFilePeerScoreStore(config.P2P.PeerScoreFile())),
+		p2p.SwitchPeerBlacklistStore(p2p.NewFilePeerBlacklistStore(config.P2P.PeerBlacklistFile())),
+	}
+	if config.P2P.DedupCapacity > 0 {
+		swOptions = append(swOptions, p2p.SwitchMessageDeduplicator(p2p.NewMessageDeduplicator(
+			config.P2P.DedupCapacity,
+			config.P2P.DedupFalsePositive,
+			config.P2P.DedupRotateInterval,
+		)))
+	}
+	sw := p2p.NewSwitch(config.P2P, transport, swOptions...)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
@@ -478,11 +553,12 @@ func NewNode(config *cfg.Config,
 		genesisDoc:    genDoc,
 		privValidator: privValidator,
 
-		transport: transport,
-		sw:        sw,
-		addrBook:  addrBook,
-		nodeInfo:  nodeInfo,
-		nodeKey:   nodeKey,
+		portMapper: portMapper,
+		transport:  transport,
+		sw:         sw,
+		addrBook:   addrBook,
+		nodeInfo:   nodeInfo,
+		nodeKey:    nodeKey,
 
 		stateDB:          stateDB,
 		blockStore:       blockStore,
@@ -494,9 +570,18 @@ func NewNode(config *cfg.Config,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		pruner:           pruner,
 		eventBus:         eventBus,
 	}
 	node.BaseService = *cmn.NewBaseService(logger, "Node", node)
+
+	if portMapper != nil {
+		portMapper.SetOnAddressChange(node.setExternalAddress)
+	}
+
+	for _, option := range options {
+		option(node)
+	}
 	return node, nil
 }
 
@@ -557,10 +642,47 @@ func (n *Node) OnStart() error {
 		}
 	}
 
+	if n.config.Store.VerifyOnStart {
+		go n.verifyBlockStore()
+	}
+
+	// start pruning the stores
+	if n.pruner != nil {
+		if err := n.pruner.Start(); err != nil {
+			return err
+		}
+	}
+
 	// start tx indexer
 	return n.indexerService.Start()
 }
 
+// verifyBlockStore verifies the blocks in the block store, logging the
+// corrupted ones, until the node stops.
+func (n *Node) verifyBlockStore() {
+	const chunk = 1000
+	height := n.blockStore.Height()
+	var corrupted int
+	for start := int64(1); start <= height; start += chunk {
+		select {
+		case <-n.Quit():
+			return
+		default:
+		}
+		end := cmn.MinInt64(start+chunk-1, height)
+		blocks, err := n.blockStore.Verify(start, end)
+		if err != nil {
+			n.Logger.Error("Failed to verify the block store", "err", err)
+			return
+		}
+		for _, block := range blocks {
+			n.Logger.Error("Corrupted block in the block store", "height", block.Height, "err", block.Err)
+		}
+		corrupted += len(blocks)
+	}
+	n.Logger.Info("Verified the block store", "height", height, "corrupted", corrupted)
+}
+
 // OnStop stops the Node. It implements cmn.Service.
 func (n *Node) OnStop() {
 	n.BaseService.OnStop()
@@ -570,6 +692,9 @@ func (n *Node) OnStop() {
 	// first stop the non-reactor services
 	n.eventBus.Stop()
 	n.indexerService.Stop()
+	if n.pruner != nil {
+		n.pruner.Stop()
+	}
 
 	// now stop the reactors
 	// TODO: gracefully disconnect from peers.
@@ -580,6 +705,13 @@ func (n *Node) OnStop() {
 		n.mempoolReactor.Mempool.CloseWAL()
 	}
 
+	// save pending txs
+	if n.config.Mempool.PersistenceEnabled() {
+		if err := n.mempoolReactor.Mempool.ClosePersistence(); err != nil {
+			n.Logger.Error("Error saving mempool txs", "err", err)
+		}
+	}
+
 	if err := n.transport.Close(); err != nil {
 		n.Logger.Error("Error closing transport", "err", err)
 	}
@@ -598,6 +730,10 @@ func (n *Node) OnStop() {
 		pvsc.Stop()
 	}
 
+	if n.portMapper != nil {
+		n.portMapper.Stop()
+	}
+
 	if n.prometheusSrv != nil {
 		if err := n.prometheusSrv.Shutdown(context.Background()); err != nil {
 			// Error from closing listeners, or context timeout:
@@ -624,6 +760,7 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetConsensusReactor(n.consensusReactor)
 	rpccore.SetEventBus(n.eventBus)
 	rpccore.SetLogger(n.Logger.With("module", "rpc"))
+	rpccore.SetConfig(*n.config.RPC)
 }
 
 func (n *Node) startRPC() ([]net.Listener, error) {
@@ -646,22 +783,33 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
 		rpcserver.RegisterRPCFuncs(mux, rpccore.Routes, coreCodec, rpcLogger)
 
-		listener, err := rpcserver.Listen(
-			listenAddr,
-			rpcserver.Config{MaxOpenConnections: n.config.RPC.MaxOpenConnections},
-		)
+		rpcConfig := rpcserver.Config{
+			MaxOpenConnections:   n.config.RPC.MaxOpenConnections,
+			MaxRequestsPerSecond: n.config.RPC.MaxRequestsPerSecond,
+			MaxRequestsBurst:     n.config.RPC.MaxRequestsBurst,
+			RateLimitWhitelist:   n.config.RPC.RateLimitWhitelist,
+			JWTSecret:            n.config.RPC.JWTSecret,
+			PublicEndpoints:      n.config.RPC.PublicEndpoints,
+			DeprecateLegacyPaths: n.config.RPC.DeprecateLegacyPaths,
+		}
+		listener, err := rpcserver.Listen(listenAddr, rpcConfig)
 		if err != nil {
 			return nil, err
 		}
 
-		var rootHandler http.Handler = mux
+		authHandler := rpcserver.AuthHandler(mux, rpcConfig, rpcLogger)
+		versionedHandler := rpcserver.VersionedHandler(authHandler, rpcConfig)
+		rootHandler, err := rpcserver.RateLimitHandler(versionedHandler, rpcConfig, rpcLogger)
+		if err != nil {
+			return nil, err
+		}
 		if n.config.RPC.IsCorsEnabled() {
 			corsMiddleware := cors.New(cors.Options{
 				AllowedOrigins: n.config.RPC.CORSAllowedOrigins,
 				AllowedMethods: n.config.RPC.CORSAllowedMethods,
 				AllowedHeaders: n.config.RPC.CORSAllowedHeaders,
 			})
-			rootHandler = corsMiddleware.Handler(mux)
+			rootHandler = corsMiddleware.Handler(rootHandler)
 		}
 
 		go rpcserver.StartHTTPServer(
@@ -675,12 +823,18 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	// we expose a simplified api over grpc for convenience to app devs
 	grpcListenAddr := n.config.RPC.GRPCListenAddress
 	if grpcListenAddr != "" {
-		listener, err := rpcserver.Listen(
-			grpcListenAddr, rpcserver.Config{MaxOpenConnections: n.config.RPC.GRPCMaxOpenConnections})
+		grpcConfig := rpcserver.Config{
+			MaxOpenConnections:   n.config.RPC.GRPCMaxOpenConnections,
+			MaxRequestsPerSecond: n.config.RPC.MaxRequestsPerSecond,
+			MaxRequestsBurst:     n.config.RPC.MaxRequestsBurst,
+			RateLimitWhitelist:   n.config.RPC.RateLimitWhitelist,
+			JWTSecret:            n.config.RPC.JWTSecret,
+		}
+		listener, err := rpcserver.Listen(grpcListenAddr, grpcConfig)
 		if err != nil {
 			return nil, err
 		}
-		go grpccore.StartGRPCServer(listener)
+		go grpccore.StartGRPCServer(listener, grpcConfig)
 		listeners = append(listeners, listener)
 	}
 
@@ -773,7 +927,23 @@ func (n *Node) IsListening() bool {
 
 // NodeInfo returns the Node's Info from the Switch.
 func (n *Node) NodeInfo() p2p.NodeInfo {
-	return n.nodeInfo
+	return n.sw.NodeInfo()
+}
+
+// setExternalAddress advertises the new external address of the router, after
+// the port mapper refreshed the mapping, to the peers connecting from now on.
+func (n *Node) setExternalAddress(ip net.IP, port int) {
+	nodeInfo, ok := n.sw.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok {
+		return
+	}
+	nodeInfo.ListenAddr = p2p.NewNetAddressIPPort(ip, uint16(port)).String()
+
+	n.transport.SetNodeInfo(nodeInfo)
+	n.sw.SetNodeInfo(nodeInfo)
+	n.addrBook.AddOurAddress(nodeInfo.NetAddress())
+
+	n.Logger.Info("External address changed", "addr", nodeInfo.ListenAddr)
 }
 
 func makeNodeInfo(
@@ -807,6 +977,10 @@ func makeNodeInfo(
 
 	if config.P2P.PexReactor {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
+		nodeInfo.Other.Capabilities = append(nodeInfo.Other.Capabilities, p2p.CapabilityPex)
+		if config.P2P.SeedMode {
+			nodeInfo.Other.Capabilities = append(nodeInfo.Other.Capabilities, p2p.CapabilitySeed)
+		}
 	}
 
 	lAddr := config.P2P.ExternalAddress
@@ -817,6 +991,10 @@ func makeNodeInfo(
 
 	nodeInfo.ListenAddr = lAddr
 
+	if config.P2P.CompressThreshold > 0 {
+		nodeInfo.Other.Compression = p2p.CompressionGzip
+	}
+
 	return nodeInfo
 }
 
@@ -879,6 +1057,55 @@ func createAndStartPrivValidatorSocketClient(
 	return pvsc, nil
 }
 
+// createAndStartRecheckConns makes the connections to the application the
+// mempool rechecks txs with, if config.RecheckWorkers > 1.
+func createAndStartRecheckConns(
+	clientCreator proxy.ClientCreator,
+	config *cfg.MempoolConfig,
+	logger log.Logger,
+) ([]proxy.AppConnMempool, error) {
+	if !config.Recheck || config.RecheckWorkers <= 1 {
+		return nil, nil
+	}
+
+	conns := make([]proxy.AppConnMempool, config.RecheckWorkers)
+	for i := range conns {
+		cli, err := clientCreator.NewABCIClient()
+		if err != nil {
+			return nil, errors.Wrap(err, "Error creating ABCI client (recheck connection)")
+		}
+		cli.SetLogger(logger.With("module", "abci-client", "connection", "recheck"))
+		if err := cli.Start(); err != nil {
+			return nil, errors.Wrap(err, "Error starting ABCI client (recheck connection)")
+		}
+		conns[i] = proxy.NewAppConnMempool(cli)
+	}
+	return conns, nil
+}
+
+func createAndStartPortMapper(
+	config *cfg.P2PConfig,
+	logger log.Logger,
+) (*upnp.PortMapper, error) {
+	addr, err := p2p.NewNetAddressStringWithOptionalID(config.ListenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := "tcp"
+	if config.Protocol == p2p.ProtocolQUIC {
+		protocol = "udp"
+	}
+
+	pm := upnp.NewPortMapper(protocol, int(addr.Port))
+	pm.SetLogger(logger)
+	if err := pm.Start(); err != nil {
+		return nil, errors.Wrap(err, "failed to start")
+	}
+
+	return pm, nil
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -14,11 +14,13 @@ import (
 
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	cfg "github.com/tendermint/tendermint/config"
+	cs "github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -116,6 +118,57 @@ func TestNodeSetAppVersion(t *testing.T) {
 	assert.Equal(t, n.nodeInfo.(p2p.DefaultNodeInfo).ProtocolVersion.App, appVersion)
 }
 
+func TestNodeConsensusProposalValidator(t *testing.T) {
+	config := cfg.ResetTestRoot("node_proposal_validator_test")
+	config.P2P.ListenAddress = "tcp://" + testFreeAddr(t)
+	config.RPC.ListenAddress = "tcp://" + testFreeAddr(t)
+	config.RPC.GRPCListenAddress = ""
+
+	validated := make(chan int64, 10)
+	validator := cs.ProposalValidatorFunc(func(block *types.Block) error {
+		select {
+		case validated <- block.Height:
+		default:
+		}
+		return nil
+	})
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	n, err := NewNode(config,
+		privval.LoadOrGenFilePV(config.PrivValidatorFile()),
+		nodeKey,
+		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		DefaultGenesisDocProviderFunc(config),
+		DefaultDBProvider,
+		DefaultMetricsProvider(config.Instrumentation),
+		log.TestingLogger(),
+		ConsensusProposalValidator(validator),
+	)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer n.Stop()
+
+	select {
+	case height := <-validated:
+		assert.Equal(t, int64(1), height)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the proposal to be validated")
+	}
+}
+
+func TestNodeSetExternalAddress(t *testing.T) {
+	config := cfg.ResetTestRoot("node_external_address_test")
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+
+	n.setExternalAddress(net.ParseIP("1.2.3.4"), 26656)
+
+	assert.Equal(t, "1.2.3.4:26656", n.NodeInfo().(p2p.DefaultNodeInfo).ListenAddr)
+	assert.Equal(t, n.NodeInfo(), n.transport.NodeInfo())
+}
+
 func TestNodeSetPrivValTCP(t *testing.T) {
 	addr := "tcp://" + testFreeAddr(t)
 
@@ -0,0 +1,207 @@
+package node
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// the state DB records are written in chunks of up to this many records
+	snapshotStateChunkSize = 1000
+
+	// the largest chunk ImportSnapshot reads: a block, with its seen commit
+	maxSnapshotChunkBytes = 2 * types.MaxBlockSizeBytes
+)
+
+// snapshotHeader is the first chunk of a snapshot.
+type snapshotHeader struct {
+	ChainID string
+	Height  int64
+}
+
+// snapshotChunk is either a block, with its seen commit, or records of the
+// state DB.
+type snapshotChunk struct {
+	Block      *types.Block
+	SeenCommit *types.Commit
+	State      []snapshotRecord
+}
+
+type snapshotRecord struct {
+	Key   []byte
+	Value []byte
+}
+
+// ExportSnapshot writes a snapshot of the chain at height to outputPath: the
+// blocks up to height, and the state after them (see sm.ExportState). Only the
+// latest height can be exported; 0 stands for it. The snapshot is a gzipped
+// stream of length prefixed amino chunks, so it can be written and read
+// without holding it in memory, and it doesn't depend on the DB backend.
+//
+// The application state isn't part of the snapshot, as it can't be read
+// through ABCI: ImportSnapshot restores it by replaying the blocks. Neither
+// are the transaction index and the evidence.
+func (n *Node) ExportSnapshot(height int64, outputPath string) (err error) {
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(outputPath) // nolint: errcheck
+		}
+	}()
+	bw := bufio.NewWriter(f)
+	zw := gzip.NewWriter(bw)
+
+	// the blocks up to the state height are already saved, and the state
+	// records are never changed, so they can be written as the state moves on
+	state := sm.LoadState(n.stateDB)
+	if height != 0 && height != state.LastBlockHeight {
+		return fmt.Errorf("Can only export the latest height %d, not %d", state.LastBlockHeight, height)
+	}
+	height = state.LastBlockHeight
+
+	header := snapshotHeader{ChainID: state.ChainID, Height: height}
+	if _, err := cdc.MarshalBinaryLengthPrefixedWriter(zw, header); err != nil {
+		return err
+	}
+	for h := int64(1); h <= height; h++ {
+		block := n.blockStore.LoadBlock(h)
+		seenCommit := n.blockStore.LoadSeenCommit(h)
+		if block == nil || seenCommit == nil {
+			return fmt.Errorf("Block %d is missing from the block store", h)
+		}
+		chunk := snapshotChunk{Block: block, SeenCommit: seenCommit}
+		if _, err := cdc.MarshalBinaryLengthPrefixedWriter(zw, chunk); err != nil {
+			return err
+		}
+	}
+
+	records := make([]snapshotRecord, 0, snapshotStateChunkSize)
+	writeRecords := func() error {
+		chunk := snapshotChunk{State: records}
+		_, err := cdc.MarshalBinaryLengthPrefixedWriter(zw, chunk)
+		records = records[:0]
+		return err
+	}
+	err = sm.ExportState(n.stateDB, state, func(key, value []byte) error {
+		records = append(records, snapshotRecord{Key: key, Value: value})
+		if len(records) < snapshotStateChunkSize {
+			return nil
+		}
+		return writeRecords()
+	})
+	if err != nil {
+		return err
+	}
+	if len(records) > 0 {
+		if err := writeRecords(); err != nil {
+			return err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// ImportSnapshot restores a snapshot written by ExportSnapshot into the
+// stores of the node, which must not be running, and must not have any block
+// yet. The node must then be recreated (e.g. by restarting the process) to
+// use the restored stores. At that point, the application state is restored
+// by replaying the blocks of the snapshot (see cs.Handshaker), so the
+// application must not have any block either.
+func (n *Node) ImportSnapshot(inputPath string) error {
+	if n.IsRunning() {
+		return errors.New("Can't import a snapshot into a running node")
+	}
+	if n.blockStore.Height() != 0 || sm.LoadState(n.stateDB).LastBlockHeight != 0 {
+		return errors.New("Can only import a snapshot into a node without blocks")
+	}
+
+	f, err := os.Open(inputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // nolint: errcheck
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return errors.Wrap(err, "Malformed snapshot")
+	}
+
+	var header snapshotHeader
+	if _, err := cdc.UnmarshalBinaryLengthPrefixedReader(zr, &header, maxSnapshotChunkBytes); err != nil {
+		return errors.Wrap(err, "Malformed snapshot")
+	}
+	if header.ChainID != n.genesisDoc.ChainID {
+		return fmt.Errorf("Snapshot of chain %q, expected %q", header.ChainID, n.genesisDoc.ChainID)
+	}
+
+	var restoredState bool
+	for {
+		var chunk snapshotChunk
+		_, err := cdc.UnmarshalBinaryLengthPrefixedReader(zr, &chunk, maxSnapshotChunkBytes)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "Malformed snapshot")
+		}
+
+		if chunk.Block != nil {
+			if err := n.importSnapshotBlock(header, chunk.Block, chunk.SeenCommit); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if n.blockStore.Height() != header.Height {
+			return fmt.Errorf("Snapshot state before block %d", n.blockStore.Height()+1)
+		}
+		batch := n.stateDB.NewBatch()
+		for _, record := range chunk.State {
+			batch.Set(record.Key, record.Value)
+		}
+		batch.WriteSync()
+		restoredState = true
+	}
+
+	if !restoredState {
+		return errors.New("Malformed snapshot: missing state")
+	}
+	if state := sm.LoadState(n.stateDB); state.LastBlockHeight != header.Height {
+		return fmt.Errorf("Snapshot state at height %d, expected %d", state.LastBlockHeight, header.Height)
+	}
+	return nil
+}
+
+func (n *Node) importSnapshotBlock(header snapshotHeader, block *types.Block, seenCommit *types.Commit) error {
+	height := n.blockStore.Height() + 1
+	if block.Height != height || height > header.Height {
+		return fmt.Errorf("Snapshot block %d, expected %d", block.Height, height)
+	}
+	if block.ChainID != header.ChainID {
+		return fmt.Errorf("Snapshot block %d of chain %q", block.Height, block.ChainID)
+	}
+	if err := block.ValidateBasic(); err != nil {
+		return errors.Wrapf(err, "Invalid snapshot block %d", block.Height)
+	}
+	if seenCommit == nil {
+		return fmt.Errorf("Snapshot block %d without commit", block.Height)
+	}
+	n.blockStore.SaveBlock(block, block.MakePartSet(types.BlockPartSizeBytes), seenCommit)
+	return nil
+}
@@ -0,0 +1,96 @@
+package node
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	cfg "github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// waitForHeight waits for n to commit the block at height.
+func waitForHeight(t *testing.T, n *Node, height int64) {
+	blockCh := make(chan interface{}, 1)
+	err := n.EventBus().Subscribe(context.Background(), "snapshot_test", types.EventQueryNewBlock, blockCh)
+	require.NoError(t, err)
+	defer n.EventBus().UnsubscribeAll(context.Background(), "snapshot_test") // nolint: errcheck
+
+	for n.BlockStore().Height() < height {
+		select {
+		case <-blockCh:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for block %d", height)
+		}
+	}
+}
+
+func TestNodeSnapshot(t *testing.T) {
+	config := cfg.ResetTestRoot("node_snapshot_export_test")
+	config.P2P.ListenAddress = "tcp://" + testFreeAddr(t)
+	config.RPC.ListenAddress = "tcp://" + testFreeAddr(t)
+	config.RPC.GRPCListenAddress = ""
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	waitForHeight(t, n, 3)
+	n.Stop()
+	n.Wait()
+
+	height := sm.LoadState(n.stateDB).LastBlockHeight
+	snapshot := filepath.Join(config.RootDir, "snapshot.gz")
+	assert.Error(t, n.ExportSnapshot(height-1, snapshot), "not the latest height")
+	require.NoError(t, n.ExportSnapshot(height, snapshot))
+
+	// import into a new node of the same chain, keeping its DBs in memory
+	config = cfg.ResetTestRoot("node_snapshot_import_test")
+	config.P2P.ListenAddress = "tcp://" + testFreeAddr(t)
+	config.RPC.ListenAddress = "tcp://" + testFreeAddr(t)
+	config.RPC.GRPCListenAddress = ""
+	dbs := make(map[string]dbm.DB)
+	newNode := func() *Node {
+		nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+		require.NoError(t, err)
+		n, err := NewNode(config,
+			privval.LoadOrGenFilePV(config.PrivValidatorFile()),
+			nodeKey,
+			proxy.NewLocalClientCreator(kvstore.NewKVStoreApplication()),
+			DefaultGenesisDocProviderFunc(config),
+			func(ctx *DBContext) (dbm.DB, error) {
+				if _, ok := dbs[ctx.ID]; !ok {
+					dbs[ctx.ID] = dbm.NewMemDB()
+				}
+				return dbs[ctx.ID], nil
+			},
+			DefaultMetricsProvider(config.Instrumentation),
+			log.TestingLogger(),
+		)
+		require.NoError(t, err)
+		return n
+	}
+
+	n = newNode()
+	require.NoError(t, n.ImportSnapshot(snapshot))
+	assert.Error(t, n.ImportSnapshot(snapshot), "blocks already imported")
+
+	// the application state is restored by replaying the blocks, and the chain
+	// goes on
+	n = newNode()
+	assert.EqualValues(t, height, n.BlockStore().Height())
+	assert.EqualValues(t, height, sm.LoadState(n.stateDB).LastBlockHeight)
+	require.NoError(t, n.Start())
+	waitForHeight(t, n, height+1)
+	n.Stop()
+	n.Wait()
+}
@@ -0,0 +1,129 @@
+package p2p
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	// CompressionGzip is advertised in DefaultNodeInfoOther.Compression by nodes
+	// able to exchange gzip compressed messages.
+	CompressionGzip = "gzip"
+
+	// flags prepended to every message encoded by CompressedCodec
+	msgFlagPlain byte = 0x00
+	msgFlagGzip  byte = 0x01
+
+	// guards against decompression bombs; matches the largest message an
+	// MConnection channel accepts by default
+	maxDecompressedMsgSize = 22020096 // 21MB
+)
+
+// Codec transforms message bytes right before they are sent to a peer and
+// right after they are received from it.
+type Codec interface {
+	Encode(msgBytes []byte) ([]byte, error)
+	Decode(msgBytes []byte) ([]byte, error)
+}
+
+// NopCodec returns a Codec which leaves messages unchanged.
+func NopCodec() Codec {
+	return nopCodec{}
+}
+
+type nopCodec struct{}
+
+func (nopCodec) Encode(msgBytes []byte) ([]byte, error) { return msgBytes, nil }
+func (nopCodec) Decode(msgBytes []byte) ([]byte, error) { return msgBytes, nil }
+
+//-----------------------------------------------------------------------------
+
+// CompressedCodec wraps a Codec and gzip-compresses messages larger than a
+// threshold. Every message is prefixed with a flag byte telling the receiver
+// whether it is compressed, so it must only be used with peers which also
+// use a CompressedCodec (see compressionEnabled).
+type CompressedCodec struct {
+	codec     Codec
+	threshold int
+}
+
+var _ Codec = (*CompressedCodec)(nil)
+
+// NewCompressedCodec returns a CompressedCodec wrapping codec, which
+// compresses encoded messages longer than threshold bytes.
+func NewCompressedCodec(codec Codec, threshold int) *CompressedCodec {
+	return &CompressedCodec{
+		codec:     codec,
+		threshold: threshold,
+	}
+}
+
+// Encode implements Codec.
+func (cc *CompressedCodec) Encode(msgBytes []byte) ([]byte, error) {
+	bz, err := cc.codec.Encode(msgBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) <= cc.threshold {
+		return append([]byte{msgFlagPlain}, bz...), nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(msgFlagGzip)
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(bz); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode implements Codec.
+func (cc *CompressedCodec) Decode(msgBytes []byte) ([]byte, error) {
+	if len(msgBytes) == 0 {
+		return nil, fmt.Errorf("missing compression flag")
+	}
+
+	var bz []byte
+	switch flag := msgBytes[0]; flag {
+	case msgFlagPlain:
+		bz = msgBytes[1:]
+	case msgFlagGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(msgBytes[1:]))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close() // nolint: errcheck
+		bz, err = ioutil.ReadAll(io.LimitReader(zr, maxDecompressedMsgSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) > maxDecompressedMsgSize {
+			return nil, fmt.Errorf("decompressed message exceeds %d bytes", maxDecompressedMsgSize)
+		}
+	default:
+		return nil, fmt.Errorf("unknown compression flag %X", flag)
+	}
+
+	return cc.codec.Decode(bz)
+}
+
+// compressionEnabled returns true if both nodes advertise gzip compression.
+func compressionEnabled(ours, theirs NodeInfo) bool {
+	ourInfo, ok := ours.(DefaultNodeInfo)
+	if !ok {
+		return false
+	}
+	theirInfo, ok := theirs.(DefaultNodeInfo)
+	if !ok {
+		return false
+	}
+	return ourInfo.Other.Compression == CompressionGzip &&
+		theirInfo.Other.Compression == CompressionGzip
+}
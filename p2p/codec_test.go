@@ -0,0 +1,53 @@
+package p2p
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedCodec(t *testing.T) {
+	cc := NewCompressedCodec(NopCodec(), 16)
+
+	testCases := []struct {
+		msg        []byte
+		compressed bool
+	}{
+		{[]byte("small"), false},
+		{bytes.Repeat([]byte("large"), 100), true},
+	}
+
+	for i, tc := range testCases {
+		bz, err := cc.Encode(tc.msg)
+		require.Nil(t, err, "#%d", i)
+		if tc.compressed {
+			assert.Equal(t, msgFlagGzip, bz[0], "#%d", i)
+			assert.True(t, len(bz) < len(tc.msg), "#%d", i)
+		} else {
+			assert.Equal(t, msgFlagPlain, bz[0], "#%d", i)
+		}
+
+		msg, err := cc.Decode(bz)
+		require.Nil(t, err, "#%d", i)
+		assert.Equal(t, tc.msg, msg, "#%d", i)
+	}
+
+	_, err := cc.Decode(nil)
+	assert.NotNil(t, err)
+	_, err = cc.Decode([]byte{0xFF, 0x01})
+	assert.NotNil(t, err)
+	_, err = cc.Decode([]byte{msgFlagGzip, 0x01})
+	assert.NotNil(t, err)
+}
+
+func TestCompressionEnabled(t *testing.T) {
+	withGzip := DefaultNodeInfo{Other: DefaultNodeInfoOther{Compression: CompressionGzip}}
+	without := DefaultNodeInfo{}
+
+	assert.True(t, compressionEnabled(withGzip, withGzip))
+	assert.False(t, compressionEnabled(withGzip, without))
+	assert.False(t, compressionEnabled(without, withGzip))
+	assert.False(t, compressionEnabled(without, without))
+}
@@ -42,6 +42,7 @@ const (
 
 type receiveCbFunc func(chID byte, msgBytes []byte)
 type errorCbFunc func(interface{})
+type rateLimitedCbFunc func(chID byte)
 
 /*
 Each peer has one `MConnection` (multiplex connection) instance.
@@ -81,6 +82,8 @@ type MConnection struct {
 	channelsIdx   map[byte]*Channel
 	onReceive     receiveCbFunc
 	onError       errorCbFunc
+	onRateLimited rateLimitedCbFunc
+	recvLimiter   RateLimiter // nil if inbound messages are not rate limited
 	errored       uint32
 	config        MConnConfig
 
@@ -96,6 +99,9 @@ type MConnection struct {
 	pongTimer     *time.Timer
 	pongTimeoutCh chan bool // true - timeout, false - peer sent pong
 
+	latency  *LatencyTracker
+	pingSent time.Time // zero if no pong is awaited; only used by sendRoutine
+
 	chStatsTimer *cmn.RepeatTimer // update channel stats periodically
 
 	created time.Time // time of creation
@@ -119,6 +125,14 @@ type MConnConfig struct {
 
 	// Maximum wait time for pongs
 	PongTimeout time.Duration `mapstructure:"pong_timeout"`
+
+	// Bounds of the adaptive ping interval, see LatencyTracker. If
+	// MaxPingInterval is 0, pings are sent every PingInterval.
+	MinPingInterval time.Duration `mapstructure:"min_ping_interval"`
+	MaxPingInterval time.Duration `mapstructure:"max_ping_interval"`
+
+	// Inbound message rate limit
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
 }
 
 // DefaultMConnConfig returns the default config.
@@ -148,6 +162,9 @@ func NewMConnectionWithConfig(conn net.Conn, chDescs []*ChannelDescriptor, onRec
 	if config.PongTimeout >= config.PingInterval {
 		panic("pongTimeout must be less than pingInterval (otherwise, next ping will reset pong timer)")
 	}
+	if config.MinPingInterval > config.MaxPingInterval {
+		panic("minPingInterval must not be greater than maxPingInterval")
+	}
 
 	mconn := &MConnection{
 		conn:          conn,
@@ -160,6 +177,7 @@ func NewMConnectionWithConfig(conn net.Conn, chDescs []*ChannelDescriptor, onRec
 		onReceive:     onReceive,
 		onError:       onError,
 		config:        config,
+		latency:       NewLatencyTracker(config.MinPingInterval, config.MaxPingInterval),
 	}
 
 	// Create channels
@@ -174,6 +192,10 @@ func NewMConnectionWithConfig(conn net.Conn, chDescs []*ChannelDescriptor, onRec
 	mconn.channels = channels
 	mconn.channelsIdx = channelsIdx
 
+	if config.RateLimit.MessagesPerSecond > 0 {
+		mconn.recvLimiter = NewTokenBucket(config.RateLimit.MessagesPerSecond, config.RateLimit.BurstSize)
+	}
+
 	mconn.BaseService = *cmn.NewBaseService(nil, "MConnection", mconn)
 
 	// maxPacketMsgSize() is a bit heavy, so call just once
@@ -182,6 +204,13 @@ func NewMConnectionWithConfig(conn net.Conn, chDescs []*ChannelDescriptor, onRec
 	return mconn
 }
 
+// SetOnRateLimited sets the callback invoked whenever an inbound message is
+// dropped because the connection exceeded its rate limit.
+// NOTE: Not goroutine safe. Must be called before Start.
+func (c *MConnection) SetOnRateLimited(cb func(chID byte)) {
+	c.onRateLimited = cb
+}
+
 func (c *MConnection) SetLogger(l log.Logger) {
 	c.BaseService.SetLogger(l)
 	for _, ch := range c.channels {
@@ -197,7 +226,7 @@ func (c *MConnection) OnStart() error {
 	c.quitSendRoutine = make(chan struct{})
 	c.doneSendRoutine = make(chan struct{})
 	c.flushTimer = cmn.NewThrottleTimer("flush", c.config.FlushThrottle)
-	c.pingTimer = cmn.NewRepeatTimer("ping", c.config.PingInterval)
+	c.pingTimer = cmn.NewRepeatTimer("ping", c.pingInterval())
 	c.pongTimeoutCh = make(chan bool, 1)
 	c.chStatsTimer = cmn.NewRepeatTimer("chStats", updateStats)
 	go c.sendRoutine()
@@ -391,8 +420,10 @@ FOR_LOOP:
 				break SELECTION
 			}
 			c.sendMonitor.Update(int(_n))
-			c.Logger.Debug("Starting pong timer", "dur", c.config.PongTimeout)
-			c.pongTimer = time.AfterFunc(c.config.PongTimeout, func() {
+			c.pingSent = time.Now()
+			pongTimeout := c.pongTimeout()
+			c.Logger.Debug("Starting pong timer", "dur", pongTimeout)
+			c.pongTimer = time.AfterFunc(pongTimeout, func() {
 				select {
 				case c.pongTimeoutCh <- true:
 				default:
@@ -405,6 +436,7 @@ FOR_LOOP:
 				err = errors.New("pong timeout")
 			} else {
 				c.stopPongTimer()
+				c.recordPong()
 			}
 		case <-c.pong:
 			c.Logger.Debug("Send Pong")
@@ -443,6 +475,42 @@ FOR_LOOP:
 	c.stopPongTimer()
 }
 
+func (c *MConnection) adaptivePing() bool {
+	return c.config.MaxPingInterval > 0
+}
+
+func (c *MConnection) pingInterval() time.Duration {
+	if c.adaptivePing() {
+		return c.latency.PingInterval()
+	}
+	return c.config.PingInterval
+}
+
+// pongTimeout is shortened for adaptive pings so that the pong is always due
+// before the next ping.
+func (c *MConnection) pongTimeout() time.Duration {
+	if c.adaptivePing() {
+		if half := c.pingInterval() / 2; half < c.config.PongTimeout {
+			return half
+		}
+	}
+	return c.config.PongTimeout
+}
+
+// recordPong feeds the round-trip time of the last ping to the latency
+// tracker and adapts the ping interval.
+func (c *MConnection) recordPong() {
+	if c.pingSent.IsZero() {
+		return // unsolicited pong
+	}
+	c.latency.AddSample(time.Since(c.pingSent))
+	c.pingSent = time.Time{}
+
+	if c.adaptivePing() {
+		c.pingTimer.ResetWithDuration(c.latency.PingInterval())
+	}
+}
+
 // Returns true if messages from channels were exhausted.
 // Blocks in accordance to .sendMonitor throttling.
 func (c *MConnection) sendSomePacketMsgs() bool {
@@ -572,7 +640,12 @@ FOR_LOOP:
 				}
 				break FOR_LOOP
 			}
-			if msgBytes != nil {
+			if msgBytes != nil && c.recvLimiter != nil && !c.recvLimiter.Allow() {
+				c.Logger.Debug("Dropping rate limited message", "chID", pkt.ChannelID, "conn", c)
+				if c.onRateLimited != nil {
+					c.onRateLimited(pkt.ChannelID)
+				}
+			} else if msgBytes != nil {
 				c.Logger.Debug("Received bytes", "chID", pkt.ChannelID, "msgBytes", fmt.Sprintf("%X", msgBytes))
 				// NOTE: This means the reactor.Receive runs in the same thread as the p2p recv routine
 				c.onReceive(pkt.ChannelID, msgBytes)
@@ -612,6 +685,7 @@ func (c *MConnection) maxPacketMsgSize() int {
 
 type ConnectionStatus struct {
 	Duration    time.Duration
+	RTT         time.Duration
 	SendMonitor flow.Status
 	RecvMonitor flow.Status
 	Channels    []ChannelStatus
@@ -628,6 +702,7 @@ type ChannelStatus struct {
 func (c *MConnection) Status() ConnectionStatus {
 	var status ConnectionStatus
 	status.Duration = time.Since(c.created)
+	status.RTT = c.latency.RTT()
 	status.SendMonitor = c.sendMonitor.Status()
 	status.RecvMonitor = c.recvMonitor.Status()
 	status.Channels = make([]ChannelStatus, len(c.channels))
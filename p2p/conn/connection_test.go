@@ -140,6 +140,55 @@ func TestMConnectionReceive(t *testing.T) {
 	}
 }
 
+func TestMConnectionReceiveRateLimited(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close() // nolint: errcheck
+	defer client.Close() // nolint: errcheck
+
+	receivedCh := make(chan []byte, 2)
+	rateLimitedCh := make(chan byte, 2)
+	onReceive := func(chID byte, msgBytes []byte) {
+		// msgBytes is reused for the next message
+		receivedCh <- append([]byte(nil), msgBytes...)
+	}
+	onError := func(r interface{}) {}
+
+	cfg := DefaultMConnConfig()
+	cfg.RateLimit = RateLimitConfig{MessagesPerSecond: 0.001, BurstSize: 1}
+	chDescs := []*ChannelDescriptor{&ChannelDescriptor{ID: 0x01, Priority: 1, SendQueueCapacity: 1}}
+	mconn1 := NewMConnectionWithConfig(client, chDescs, onReceive, onError, cfg)
+	mconn1.SetLogger(log.TestingLogger())
+	mconn1.SetOnRateLimited(func(chID byte) { rateLimitedCh <- chID })
+	err := mconn1.Start()
+	require.Nil(t, err)
+	defer mconn1.Stop()
+
+	mconn2 := createTestMConnection(server)
+	err = mconn2.Start()
+	require.Nil(t, err)
+	defer mconn2.Stop()
+
+	msg := []byte("Cyclops")
+	assert.True(t, mconn2.Send(0x01, msg))
+	assert.True(t, mconn2.Send(0x01, msg))
+
+	select {
+	case receivedBytes := <-receivedCh:
+		assert.Equal(t, msg, receivedBytes)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatalf("Did not receive %s message in 500ms", msg)
+	}
+
+	select {
+	case chID := <-rateLimitedCh:
+		assert.Equal(t, byte(0x01), chID)
+	case <-receivedCh:
+		t.Fatal("Expected second message to be dropped")
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Expected second message to be rate limited in 500ms")
+	}
+}
+
 func TestMConnectionStatus(t *testing.T) {
 	server, client := NetPipe()
 	defer server.Close() // nolint: errcheck
@@ -155,6 +204,45 @@ func TestMConnectionStatus(t *testing.T) {
 	assert.Zero(t, status.Channels[0].SendQueueSize)
 }
 
+func TestMConnectionStatusRTT(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	onReceive := func(chID byte, msgBytes []byte) {}
+	onError := func(r interface{}) {}
+
+	cfg := DefaultMConnConfig()
+	cfg.PingInterval = 90 * time.Millisecond
+	cfg.PongTimeout = 45 * time.Millisecond
+	cfg.MinPingInterval = 30 * time.Millisecond
+	cfg.MaxPingInterval = 90 * time.Millisecond
+	chDescs := []*ChannelDescriptor{&ChannelDescriptor{ID: 0x01, Priority: 1, SendQueueCapacity: 1}}
+	mconn := NewMConnectionWithConfig(client, chDescs, onReceive, onError, cfg)
+	mconn.SetLogger(log.TestingLogger())
+	err := mconn.Start()
+	require.Nil(t, err)
+	defer mconn.Stop()
+
+	assert.Zero(t, mconn.Status().RTT)
+
+	// answer the first ping after a delay
+	var ping PacketPing
+	_, err = cdc.UnmarshalBinaryLengthPrefixedReader(server, &ping, maxPingPongPacketSize)
+	require.Nil(t, err)
+	time.Sleep(10 * time.Millisecond)
+	_, err = server.Write(cdc.MustMarshalBinaryLengthPrefixed(PacketPong{}))
+	require.Nil(t, err)
+
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for mconn.Status().RTT == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.True(t, mconn.Status().RTT >= 10*time.Millisecond,
+		"expected RTT of at least 10ms, got %v", mconn.Status().RTT)
+	assert.True(t, mconn.IsRunning())
+}
+
 func TestMConnectionPongTimeoutResultsInError(t *testing.T) {
 	server, client := net.Pipe()
 	defer server.Close()
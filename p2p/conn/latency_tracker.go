@@ -0,0 +1,90 @@
+package conn
+
+import (
+	"sync"
+	"time"
+)
+
+// minPingInterval is the lower bound of the ping interval, whatever the
+// configured bounds.
+const minPingInterval = 100 * time.Millisecond
+
+// LatencyTracker keeps a rolling average of a connection's round-trip time,
+// measured from ping/pong exchanges, and derives the ping interval from it.
+//
+// Like TCP's retransmission timer (RFC 6298) it keeps a smoothed RTT and the
+// mean RTT deviation. Peers with a stable RTT are pinged every maxInterval;
+// the more the RTT jitters relative to its average, the closer the interval
+// gets to minInterval, so that a failing connection is detected earlier.
+type LatencyTracker struct {
+	mtx sync.Mutex
+
+	minInterval time.Duration
+	maxInterval time.Duration
+
+	srtt    time.Duration // smoothed round-trip time
+	rttvar  time.Duration // round-trip time variation
+	samples int
+}
+
+// NewLatencyTracker returns a LatencyTracker adjusting the ping interval
+// between minInterval and maxInterval.
+func NewLatencyTracker(minInterval, maxInterval time.Duration) *LatencyTracker {
+	return &LatencyTracker{
+		minInterval: minInterval,
+		maxInterval: maxInterval,
+	}
+}
+
+// AddSample records a measured round-trip time.
+func (lt *LatencyTracker) AddSample(rtt time.Duration) {
+	lt.mtx.Lock()
+	defer lt.mtx.Unlock()
+
+	if lt.samples == 0 {
+		lt.srtt = rtt
+		lt.rttvar = rtt / 2
+	} else {
+		// rttvar = 3/4 * rttvar + 1/4 * |srtt - rtt|
+		// srtt   = 7/8 * srtt   + 1/8 * rtt
+		dev := lt.srtt - rtt
+		if dev < 0 {
+			dev = -dev
+		}
+		lt.rttvar = (3*lt.rttvar + dev) / 4
+		lt.srtt = (7*lt.srtt + rtt) / 8
+	}
+	lt.samples++
+}
+
+// RTT returns the smoothed round-trip time, or 0 if nothing was measured yet.
+func (lt *LatencyTracker) RTT() time.Duration {
+	lt.mtx.Lock()
+	defer lt.mtx.Unlock()
+	return lt.srtt
+}
+
+// PingInterval returns the interval at which the peer should be pinged. It is
+// maxInterval until enough samples were recorded to judge the jitter, and never
+// less than minPingInterval.
+func (lt *LatencyTracker) PingInterval() time.Duration {
+	lt.mtx.Lock()
+	defer lt.mtx.Unlock()
+
+	interval := lt.maxInterval
+	if lt.samples >= 2 && lt.srtt > 0 {
+		// Deviation as a fraction of the average RTT, capped at 1.
+		jitter := float64(lt.rttvar) / float64(lt.srtt)
+		if jitter > 1 {
+			jitter = 1
+		}
+
+		span := float64(lt.maxInterval - lt.minInterval)
+		interval = lt.maxInterval - time.Duration(jitter*span)
+	}
+
+	if interval < minPingInterval {
+		return minPingInterval
+	}
+	return interval
+}
@@ -0,0 +1,61 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencyTrackerRTT(t *testing.T) {
+	lt := NewLatencyTracker(10*time.Second, 60*time.Second)
+	assert.Zero(t, lt.RTT())
+
+	lt.AddSample(100 * time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, lt.RTT())
+
+	lt.AddSample(180 * time.Millisecond)
+	assert.Equal(t, 110*time.Millisecond, lt.RTT())
+}
+
+func TestLatencyTrackerPingInterval(t *testing.T) {
+	minInterval, maxInterval := 10*time.Second, 60*time.Second
+
+	// no samples yet
+	lt := NewLatencyTracker(minInterval, maxInterval)
+	assert.Equal(t, maxInterval, lt.PingInterval())
+
+	// stable latency converges to the maximum interval
+	stable := NewLatencyTracker(minInterval, maxInterval)
+	for i := 0; i < 50; i++ {
+		stable.AddSample(500 * time.Millisecond)
+	}
+	assert.InDelta(t, float64(maxInterval), float64(stable.PingInterval()), float64(time.Second))
+
+	// jittery latency is pinged more often
+	jittery := NewLatencyTracker(minInterval, maxInterval)
+	for i := 0; i < 50; i++ {
+		if i%2 == 0 {
+			jittery.AddSample(10 * time.Millisecond)
+		} else {
+			jittery.AddSample(900 * time.Millisecond)
+		}
+	}
+	interval := jittery.PingInterval()
+	assert.True(t, interval < stable.PingInterval())
+	assert.True(t, interval >= minInterval)
+}
+
+func TestLatencyTrackerPingIntervalLowerBound(t *testing.T) {
+	lt := NewLatencyTracker(0, 0)
+	assert.Equal(t, minPingInterval, lt.PingInterval())
+
+	for i := 0; i < 50; i++ {
+		if i%2 == 0 {
+			lt.AddSample(10 * time.Millisecond)
+		} else {
+			lt.AddSample(900 * time.Millisecond)
+		}
+	}
+	assert.Equal(t, minPingInterval, lt.PingInterval())
+}
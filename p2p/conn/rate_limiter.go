@@ -0,0 +1,67 @@
+package conn
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter decides whether an event may happen now.
+type RateLimiter interface {
+	Allow() bool
+}
+
+// RateLimitConfig configures the per-connection inbound message rate limit.
+// A zero MessagesPerSecond disables rate limiting.
+type RateLimitConfig struct {
+	// Sustained number of messages per second
+	MessagesPerSecond float64 `mapstructure:"messages_per_second"`
+
+	// Maximum number of messages allowed in a single burst
+	BurstSize int `mapstructure:"burst_size"`
+}
+
+// tokenBucket is a RateLimiter which refills at a constant rate up to a
+// maximum burst size.
+type tokenBucket struct {
+	mtx sync.Mutex
+
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64
+	last   time.Time
+}
+
+var _ RateLimiter = (*tokenBucket)(nil)
+
+// NewTokenBucket returns a token-bucket RateLimiter allowing rate events per
+// second on average and up to burst events at once. The bucket starts full.
+func NewTokenBucket(rate float64, burst int) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Allow implements RateLimiter. It consumes a token if one is available.
+func (tb *tokenBucket) Allow() bool {
+	tb.mtx.Lock()
+	defer tb.mtx.Unlock()
+
+	now := time.Now()
+	tb.tokens += now.Sub(tb.last).Seconds() * tb.rate
+	if tb.tokens > tb.burst {
+		tb.tokens = tb.burst
+	}
+	tb.last = now
+
+	if tb.tokens < 1 {
+		return false
+	}
+	tb.tokens--
+	return true
+}
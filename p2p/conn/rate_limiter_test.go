@@ -0,0 +1,28 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	rl := NewTokenBucket(100, 3)
+
+	// the bucket starts full
+	for i := 0; i < 3; i++ {
+		assert.True(t, rl.Allow(), "event #%d should be allowed", i)
+	}
+	assert.False(t, rl.Allow())
+
+	// 100 tokens per second refills a token in 10ms
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, rl.Allow())
+}
+
+func TestTokenBucketMinBurst(t *testing.T) {
+	rl := NewTokenBucket(0.001, 0)
+	assert.True(t, rl.Allow())
+	assert.False(t, rl.Allow())
+}
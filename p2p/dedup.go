@@ -0,0 +1,142 @@
+package p2p
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+)
+
+// MessageDeduplicator remembers the IDs (hashes) of recently received
+// messages, so reactors can drop gossip they already got from another peer.
+//
+// IDs are kept in a rolling bloom filter: a fresh filter is started every
+// rotateInterval and the previous one is still consulted, so an ID is
+// remembered for between one and two intervals. Like any bloom filter it may
+// report an ID it has never seen (at the configured false positive rate while
+// at most capacity IDs are added per interval), but never misses one it has.
+type MessageDeduplicator struct {
+	mtx sync.Mutex
+
+	capacity       int
+	fpRate         float64
+	rotateInterval time.Duration
+
+	current  *bloomFilter
+	previous *bloomFilter
+	rotated  time.Time
+}
+
+// NewMessageDeduplicator returns a MessageDeduplicator sized for capacity
+// messages per rotateInterval at the false positive rate fpRate.
+func NewMessageDeduplicator(capacity int, fpRate float64, rotateInterval time.Duration) *MessageDeduplicator {
+	return &MessageDeduplicator{
+		capacity:       capacity,
+		fpRate:         fpRate,
+		rotateInterval: rotateInterval,
+		current:        newBloomFilter(capacity, fpRate),
+		previous:       newBloomFilter(capacity, fpRate),
+		rotated:        time.Now(),
+	}
+}
+
+// Seen returns true if msgID was probably seen before. Otherwise it records
+// msgID and returns false.
+func (md *MessageDeduplicator) Seen(msgID []byte) bool {
+	md.mtx.Lock()
+	defer md.mtx.Unlock()
+
+	h1, h2 := bloomHashes(msgID)
+	if md.has(h1, h2) {
+		return true
+	}
+	md.current.add(h1, h2)
+	return false
+}
+
+// Has returns true if msgID was probably recorded before, without recording
+// it.
+func (md *MessageDeduplicator) Has(msgID []byte) bool {
+	md.mtx.Lock()
+	defer md.mtx.Unlock()
+	return md.has(bloomHashes(msgID))
+}
+
+// Add records msgID.
+func (md *MessageDeduplicator) Add(msgID []byte) {
+	md.mtx.Lock()
+	defer md.mtx.Unlock()
+
+	if time.Since(md.rotated) >= md.rotateInterval {
+		md.rotate()
+	}
+	md.current.add(bloomHashes(msgID))
+}
+
+// CONTRACT: md.mtx is held.
+func (md *MessageDeduplicator) has(h1, h2 uint64) bool {
+	if time.Since(md.rotated) >= md.rotateInterval {
+		md.rotate()
+	}
+	return md.current.has(h1, h2) || md.previous.has(h1, h2)
+}
+
+// CONTRACT: md.mtx is held.
+func (md *MessageDeduplicator) rotate() {
+	md.previous = md.current
+	md.current = newBloomFilter(md.capacity, md.fpRate)
+	md.rotated = time.Now()
+}
+
+//-----------------------------------------------------------------------------
+
+// bloomFilter is a fixed size bloom filter using double hashing.
+type bloomFilter struct {
+	bits    []uint64
+	numBits uint64
+	numHash uint64
+}
+
+// newBloomFilter returns a bloom filter holding n items at false positive
+// rate p, with m = -n*ln(p)/ln(2)^2 bits and k = m/n*ln(2) hash functions.
+func newBloomFilter(n int, p float64) *bloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	if m < 64 {
+		m = 64
+	}
+	k := math.Round(m / float64(n) * math.Ln2)
+	if k < 1 {
+		k = 1
+	}
+	return &bloomFilter{
+		bits:    make([]uint64, (uint64(m)+63)/64),
+		numBits: uint64(m),
+		numHash: uint64(k),
+	}
+}
+
+func bloomHashes(data []byte) (uint64, uint64) {
+	sum := sha256.Sum256(data)
+	return binary.BigEndian.Uint64(sum[0:8]), binary.BigEndian.Uint64(sum[8:16])
+}
+
+func (bf *bloomFilter) add(h1, h2 uint64) {
+	for i := uint64(0); i < bf.numHash; i++ {
+		pos := (h1 + i*h2) % bf.numBits
+		bf.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+func (bf *bloomFilter) has(h1, h2 uint64) bool {
+	for i := uint64(0); i < bf.numHash; i++ {
+		pos := (h1 + i*h2) % bf.numBits
+		if bf.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageDeduplicatorSeen(t *testing.T) {
+	md := NewMessageDeduplicator(100, 0.001, time.Hour)
+
+	assert.False(t, md.Seen([]byte("tx1")))
+	assert.True(t, md.Seen([]byte("tx1")))
+	assert.False(t, md.Seen([]byte("tx2")))
+	assert.True(t, md.Seen([]byte("tx2")))
+}
+
+func TestMessageDeduplicatorRotates(t *testing.T) {
+	md := NewMessageDeduplicator(100, 0.001, 50*time.Millisecond)
+	assert.False(t, md.Seen([]byte("tx1")))
+
+	// still remembered by the previous filter after one rotation
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, md.Seen([]byte("tx1")))
+
+	// forgotten after two
+	time.Sleep(60 * time.Millisecond)
+	md.Seen([]byte("tx2"))
+	time.Sleep(60 * time.Millisecond)
+	assert.False(t, md.Seen([]byte("tx1")))
+}
+
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	const n, p = 1000, 0.01
+	bf := newBloomFilter(n, p)
+	for i := 0; i < n; i++ {
+		bf.add(bloomHashes([]byte(fmt.Sprintf("in-%d", i))))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10*n; i++ {
+		if bf.has(bloomHashes([]byte(fmt.Sprintf("out-%d", i)))) {
+			falsePositives++
+		}
+	}
+	assert.True(t, float64(falsePositives)/(10*n) < 2*p,
+		"false positive rate %v exceeds %v", float64(falsePositives)/(10*n), 2*p)
+}
@@ -50,6 +50,11 @@ func (p *peer) NodeInfo() p2p.NodeInfo {
 	return p2p.DefaultNodeInfo{}
 }
 
+// Meta always returns empty metadata.
+func (p *peer) Meta() p2p.PeerMeta {
+	return p2p.PeerMeta{}
+}
+
 // RemoteIP always returns localhost.
 func (p *peer) RemoteIP() net.IP {
 	return net.ParseIP("127.0.0.1")
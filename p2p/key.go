@@ -18,6 +18,18 @@ type ID string
 // TODO: support other length addresses ?
 const IDByteLength = crypto.AddressSize
 
+// Validate returns an error if the ID isn't a hex encoded address.
+func (id ID) Validate() error {
+	idBytes, err := hex.DecodeString(string(id))
+	if err != nil {
+		return err
+	}
+	if len(idBytes) != IDByteLength {
+		return fmt.Errorf("invalid hex length - got %d, expected %d", len(idBytes), IDByteLength)
+	}
+	return nil
+}
+
 //------------------------------------------------------------------------------
 // Persistent peer ID
 // TODO: encrypt on disk
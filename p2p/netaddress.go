@@ -5,7 +5,6 @@
 package p2p
 
 import (
-	"encoding/hex"
 	"flag"
 	"fmt"
 	"net"
@@ -39,12 +38,16 @@ func IDAddressString(id ID, protocolHostPort string) string {
 	return fmt.Sprintf("%s@%s", id, hostPort)
 }
 
-// NewNetAddress returns a new NetAddress using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
+// NewNetAddress returns a new NetAddress using the provided TCP (or UDP, for
+// QUIC) address. When testing, other net.Addr (except TCP and UDP) will result
+// in using 0.0.0.0:0. When normal run, other net.Addr (except TCP and UDP) will
 // panic.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
+	// QUIC connections are addressed by udp ip and port.
+	if udpAddr, ok := addr.(*net.UDPAddr); ok {
+		addr = &net.TCPAddr{IP: udpAddr.IP, Port: udpAddr.Port, Zone: udpAddr.Zone}
+	}
 	tcpAddr, ok := addr.(*net.TCPAddr)
 	if !ok {
 		if flag.Lookup("test.v") == nil { // normal run
@@ -83,18 +86,11 @@ func NewNetAddressStringWithOptionalID(addr string) (*NetAddress, error) {
 	var id ID
 	spl := strings.Split(addrWithoutProtocol, "@")
 	if len(spl) == 2 {
-		idStr := spl[0]
-		idBytes, err := hex.DecodeString(idStr)
-		if err != nil {
+		if err := ID(spl[0]).Validate(); err != nil {
 			return nil, ErrNetAddressInvalid{addrWithoutProtocol, err}
 		}
-		if len(idBytes) != IDByteLength {
-			return nil, ErrNetAddressInvalid{
-				addrWithoutProtocol,
-				fmt.Errorf("invalid hex length - got %d, expected %d", len(idBytes), IDByteLength)}
-		}
 
-		id, addrWithoutProtocol = ID(idStr), spl[1]
+		id, addrWithoutProtocol = ID(spl[0]), spl[1]
 	}
 
 	host, portStr, err := net.SplitHostPort(addrWithoutProtocol)
@@ -220,11 +216,8 @@ func (na *NetAddress) Routable() bool {
 // For IPv4 these are either a 0 or all bits set address. For IPv6 a zero
 // address or one that matches the RFC3849 documentation address format.
 func (na *NetAddress) Valid() bool {
-	if string(na.ID) != "" {
-		data, err := hex.DecodeString(string(na.ID))
-		if err != nil || len(data) != IDByteLength {
-			return false
-		}
+	if string(na.ID) != "" && na.ID.Validate() != nil {
+		return false
 	}
 	return na.IP != nil && !(na.IP.IsUnspecified() || na.RFC3849() ||
 		na.IP.Equal(net.IPv4bcast))
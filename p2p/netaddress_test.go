@@ -18,6 +18,9 @@ func TestNewNetAddress(t *testing.T) {
 	assert.NotPanics(t, func() {
 		NewNetAddress("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
 	}, "Calling NewNetAddress with UDPAddr should not panic in testing")
+
+	udpAddr := NewNetAddress("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
+	assert.Equal(t, "127.0.0.1:8000", udpAddr.String())
 }
 
 func TestNewNetAddressStringWithOptionalID(t *testing.T) {
@@ -94,8 +94,13 @@ type DefaultNodeInfo struct {
 
 // DefaultNodeInfoOther is the misc. applcation specific data
 type DefaultNodeInfoOther struct {
-	TxIndex    string `json:"tx_index"`
-	RPCAddress string `json:"rpc_address"`
+	TxIndex     string `json:"tx_index"`
+	RPCAddress  string `json:"rpc_address"`
+	Compression string `json:"compression"` // CompressionGzip or empty
+
+	// Exposed as PeerMeta
+	Capabilities []string         `json:"capabilities"`
+	ExtraData    []ExtraDataEntry `json:"extra_data"`
 }
 
 // ID returns the node's peer ID.
@@ -166,6 +171,12 @@ func (info DefaultNodeInfo) ValidateBasic() error {
 	if len(rpcAddr) > 0 && (!cmn.IsASCIIText(rpcAddr) || cmn.ASCIITrim(rpcAddr) == "") {
 		return fmt.Errorf("info.Other.RPCAddress=%v must be valid ASCII text without tabs", rpcAddr)
 	}
+	if err := validateCapabilities(other.Capabilities); err != nil {
+		return err
+	}
+	if err := validateExtraData(other.ExtraData); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -60,6 +60,20 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Empty space RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = emptySpace }, true},
 		{"Empty RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "" }, false},
 		{"Good RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "0.0.0.0:26657" }, false},
+
+		{"Non-ASCII Capability", func(ni *DefaultNodeInfo) { ni.Other.Capabilities = []string{nonAscii} }, true},
+		{"Empty Capability", func(ni *DefaultNodeInfo) { ni.Other.Capabilities = []string{""} }, true},
+		{"Too Many Capabilities", func(ni *DefaultNodeInfo) {
+			ni.Other.Capabilities = make([]string, maxNumCapabilities+1)
+			for i := range ni.Other.Capabilities {
+				ni.Other.Capabilities[i] = fmt.Sprintf("cap%d", i)
+			}
+		}, true},
+		{"Good Capabilities", func(ni *DefaultNodeInfo) { ni.Other.Capabilities = []string{CapabilityPex} }, false},
+
+		{"Empty ExtraData Key", func(ni *DefaultNodeInfo) { ni.Other.ExtraData = []ExtraDataEntry{{"", "v"}} }, true},
+		{"Duplicate ExtraData Key", func(ni *DefaultNodeInfo) { ni.Other.ExtraData = []ExtraDataEntry{{"k", "v"}, {"k", "w"}} }, true},
+		{"Good ExtraData", func(ni *DefaultNodeInfo) { ni.Other.ExtraData = []ExtraDataEntry{{"k", "v"}, {"l", ""}} }, false},
 	}
 
 	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
@@ -121,3 +135,22 @@ func TestNodeInfoCompatible(t *testing.T) {
 		assert.Error(t, ni1.CompatibleWith(ni))
 	}
 }
+
+func TestNodeInfoMeta(t *testing.T) {
+	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	ni := testNodeInfo(nodeKey.ID(), "testing").(DefaultNodeInfo)
+	ni.Other.Capabilities = []string{CapabilityPex}
+	ni.Other.ExtraData = []ExtraDataEntry{{"region", "eu"}}
+
+	// the metadata survives the handshake encoding
+	var decoded DefaultNodeInfo
+	err := cdc.UnmarshalBinaryLengthPrefixed(cdc.MustMarshalBinaryLengthPrefixed(ni), &decoded)
+	assert.NoError(t, err)
+
+	meta := decoded.Meta()
+	assert.Equal(t, ni.Version, meta.Version)
+	assert.Equal(t, ni.Network, meta.ChainID)
+	assert.True(t, meta.HasCapability(CapabilityPex))
+	assert.False(t, meta.HasCapability(CapabilitySeed))
+	assert.Equal(t, map[string]string{"region": "eu"}, meta.ExtraData)
+}
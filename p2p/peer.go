@@ -25,6 +25,7 @@ type Peer interface {
 	IsPersistent() bool // do we redial this peer when we disconnect
 
 	NodeInfo() NodeInfo // peer's info
+	Meta() PeerMeta     // peer's metadata, derived from its NodeInfo
 	Status() tmconn.ConnectionStatus
 	OriginalAddr() *NetAddress
 
@@ -109,6 +110,9 @@ type peer struct {
 	// User data
 	Data *cmn.CMap
 
+	// encodes outbound and decodes inbound messages
+	codec Codec
+
 	metrics       *Metrics
 	metricsTicker *time.Ticker
 }
@@ -129,6 +133,7 @@ func newPeer(
 		nodeInfo:      nodeInfo,
 		channels:      nodeInfo.(DefaultNodeInfo).Channels, // TODO
 		Data:          cmn.NewCMap(),
+		codec:         NopCodec(),
 		metricsTicker: time.NewTicker(metricsTickerDuration),
 		metrics:       NopMetrics(),
 	}
@@ -220,6 +225,15 @@ func (p *peer) NodeInfo() NodeInfo {
 	return p.nodeInfo
 }
 
+// Meta returns the peer's metadata. It is empty unless the peer's NodeInfo is
+// a DefaultNodeInfo.
+func (p *peer) Meta() PeerMeta {
+	if info, ok := p.nodeInfo.(DefaultNodeInfo); ok {
+		return info.Meta()
+	}
+	return PeerMeta{}
+}
+
 // OriginalAddr returns the original address, which was used to connect with
 // the peer. Returns nil for inbound peers.
 func (p *peer) OriginalAddr() *NetAddress {
@@ -244,7 +258,12 @@ func (p *peer) Send(chID byte, msgBytes []byte) bool {
 	} else if !p.hasChannel(chID) {
 		return false
 	}
-	res := p.mconn.Send(chID, msgBytes)
+	bz, err := p.codec.Encode(msgBytes)
+	if err != nil {
+		p.Logger.Error("Failed to encode message", "chID", chID, "err", err)
+		return false
+	}
+	res := p.mconn.Send(chID, bz)
 	if res {
 		p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID())).Add(float64(len(msgBytes)))
 	}
@@ -259,7 +278,12 @@ func (p *peer) TrySend(chID byte, msgBytes []byte) bool {
 	} else if !p.hasChannel(chID) {
 		return false
 	}
-	res := p.mconn.TrySend(chID, msgBytes)
+	bz, err := p.codec.Encode(msgBytes)
+	if err != nil {
+		p.Logger.Error("Failed to encode message", "chID", chID, "err", err)
+		return false
+	}
+	res := p.mconn.TrySend(chID, bz)
 	if res {
 		p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID())).Add(float64(len(msgBytes)))
 	}
@@ -326,6 +350,27 @@ func PeerMetrics(metrics *Metrics) PeerOption {
 	}
 }
 
+// PeerCodec sets the Codec used to encode and decode messages exchanged with
+// the peer.
+func PeerCodec(codec Codec) PeerOption {
+	return func(p *peer) {
+		p.codec = codec
+	}
+}
+
+// PeerOnRateLimited sets the callback invoked when a message from the peer is
+// dropped because it exceeded the connection rate limit.
+func PeerOnRateLimited(cb func(Peer, byte)) PeerOption {
+	return func(p *peer) {
+		if cb == nil {
+			return
+		}
+		p.mconn.SetOnRateLimited(func(chID byte) {
+			cb(p, chID)
+		})
+	}
+}
+
 func (p *peer) metricsReporter() {
 	for {
 		select {
@@ -362,6 +407,11 @@ func createMConnection(
 			// which does onPeerError.
 			panic(fmt.Sprintf("Unknown channel %X", chID))
 		}
+		msgBytes, err := p.codec.Decode(msgBytes)
+		if err != nil {
+			// Also caught in conn._recover.
+			panic(fmt.Sprintf("Failed to decode message on channel %X: %v", chID, err))
+		}
 		p.metrics.PeerReceiveBytesTotal.With("peer_id", string(p.ID())).Add(float64(len(msgBytes)))
 		reactor.Receive(chID, p, msgBytes)
 	}
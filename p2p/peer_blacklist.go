@@ -0,0 +1,60 @@
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// PeerBlacklistStore persists the peer blacklist so that bans survive node
+// restarts. Entries map a peer ID, or an IP, to the time its ban expires; the
+// zero time means the ban never expires.
+type PeerBlacklistStore interface {
+	Save(blacklist map[string]time.Time) error
+	Load() (map[string]time.Time, error)
+}
+
+//-----------------------------------------------------------------------------
+
+// FilePeerBlacklistStore is a PeerBlacklistStore backed by a JSON file.
+type FilePeerBlacklistStore struct {
+	filePath string
+}
+
+var _ PeerBlacklistStore = (*FilePeerBlacklistStore)(nil)
+
+// NewFilePeerBlacklistStore returns a store that saves the blacklist to
+// filePath.
+func NewFilePeerBlacklistStore(filePath string) *FilePeerBlacklistStore {
+	return &FilePeerBlacklistStore{filePath: filePath}
+}
+
+// Save implements PeerBlacklistStore. The file is written atomically.
+func (s *FilePeerBlacklistStore) Save(blacklist map[string]time.Time) error {
+	jsonBytes, err := json.MarshalIndent(blacklist, "", "\t")
+	if err != nil {
+		return err
+	}
+	return cmn.WriteFileAtomic(s.filePath, jsonBytes, 0644)
+}
+
+// Load implements PeerBlacklistStore. It returns an empty blacklist if the
+// file does not exist yet.
+func (s *FilePeerBlacklistStore) Load() (map[string]time.Time, error) {
+	blacklist := make(map[string]time.Time)
+
+	jsonBytes, err := ioutil.ReadFile(s.filePath)
+	if os.IsNotExist(err) {
+		return blacklist, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(jsonBytes, &blacklist); err != nil {
+		return nil, err
+	}
+	return blacklist, nil
+}
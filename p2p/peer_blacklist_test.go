@@ -0,0 +1,35 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePeerBlacklistStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peer_blacklist")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewFilePeerBlacklistStore(filepath.Join(dir, "peer_blacklist.json"))
+
+	// missing file is not an error
+	blacklist, err := store.Load()
+	require.Nil(t, err)
+	assert.Empty(t, blacklist)
+
+	expiry := time.Now().Add(time.Hour).Round(0)
+	want := map[string]time.Time{"peer1": expiry, "peer2": time.Time{}}
+	require.Nil(t, store.Save(want))
+
+	blacklist, err = store.Load()
+	require.Nil(t, err)
+	require.Len(t, blacklist, 2)
+	assert.True(t, expiry.Equal(blacklist["peer1"]))
+	assert.True(t, blacklist["peer2"].IsZero())
+}
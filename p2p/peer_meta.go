@@ -0,0 +1,91 @@
+package p2p
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// Capabilities advertised by nodes built with makeNodeInfo.
+	CapabilityPex  = "pex"
+	CapabilitySeed = "seed"
+
+	maxNumCapabilities = 32
+	maxNumExtraData    = 32
+)
+
+// PeerMeta is the metadata a peer sends about itself during the handshake,
+// after the connection is authenticated. Reactors may use it to make routing
+// decisions, e.g. to only gossip to peers with a certain capability.
+//
+// Peers whose ChainID differs from ours are rejected during the handshake
+// (see DefaultNodeInfo.CompatibleWith).
+type PeerMeta struct {
+	Version      string            `json:"version"`
+	ChainID      string            `json:"chain_id"`
+	Capabilities []string          `json:"capabilities"`
+	ExtraData    map[string]string `json:"extra_data"`
+}
+
+// HasCapability returns true if the peer advertises the capability.
+func (pm PeerMeta) HasCapability(capability string) bool {
+	for _, c := range pm.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtraDataEntry is a key/value pair of PeerMeta.ExtraData, as sent over the
+// wire (amino can't encode maps).
+type ExtraDataEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Meta returns the PeerMeta described by the DefaultNodeInfo.
+func (info DefaultNodeInfo) Meta() PeerMeta {
+	extraData := make(map[string]string, len(info.Other.ExtraData))
+	for _, e := range info.Other.ExtraData {
+		extraData[e.Key] = e.Value
+	}
+	return PeerMeta{
+		Version:      info.Version,
+		ChainID:      info.Network,
+		Capabilities: info.Other.Capabilities,
+		ExtraData:    extraData,
+	}
+}
+
+func validateCapabilities(capabilities []string) error {
+	if len(capabilities) > maxNumCapabilities {
+		return fmt.Errorf("info.Other.Capabilities is too long (%v). Max is %v",
+			len(capabilities), maxNumCapabilities)
+	}
+	for _, c := range capabilities {
+		if !cmn.IsASCIIText(c) || cmn.ASCIITrim(c) == "" {
+			return fmt.Errorf("info.Other.Capabilities must be valid non-empty ASCII text without tabs, but got %v", c)
+		}
+	}
+	return nil
+}
+
+func validateExtraData(extraData []ExtraDataEntry) error {
+	if len(extraData) > maxNumExtraData {
+		return fmt.Errorf("info.Other.ExtraData is too long (%v). Max is %v",
+			len(extraData), maxNumExtraData)
+	}
+	keys := make(map[string]struct{}, len(extraData))
+	for _, e := range extraData {
+		if !cmn.IsASCIIText(e.Key) || cmn.ASCIITrim(e.Key) == "" {
+			return fmt.Errorf("info.Other.ExtraData keys must be valid non-empty ASCII text without tabs, but got %v", e.Key)
+		}
+		if _, ok := keys[e.Key]; ok {
+			return fmt.Errorf("info.Other.ExtraData contains duplicate key %v", e.Key)
+		}
+		keys[e.Key] = struct{}{}
+	}
+	return nil
+}
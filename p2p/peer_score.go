@@ -0,0 +1,57 @@
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// PeerScoreStore persists peer scores so that peer reputation survives node
+// restarts.
+type PeerScoreStore interface {
+	Save(scores map[ID]float64) error
+	Load() (map[ID]float64, error)
+}
+
+//-----------------------------------------------------------------------------
+
+// FilePeerScoreStore is a PeerScoreStore backed by a JSON file.
+type FilePeerScoreStore struct {
+	filePath string
+}
+
+var _ PeerScoreStore = (*FilePeerScoreStore)(nil)
+
+// NewFilePeerScoreStore returns a store that saves peer scores to filePath.
+func NewFilePeerScoreStore(filePath string) *FilePeerScoreStore {
+	return &FilePeerScoreStore{filePath: filePath}
+}
+
+// Save implements PeerScoreStore. The file is written atomically.
+func (s *FilePeerScoreStore) Save(scores map[ID]float64) error {
+	jsonBytes, err := json.MarshalIndent(scores, "", "\t")
+	if err != nil {
+		return err
+	}
+	return cmn.WriteFileAtomic(s.filePath, jsonBytes, 0644)
+}
+
+// Load implements PeerScoreStore. It returns an empty map if the file does
+// not exist yet.
+func (s *FilePeerScoreStore) Load() (map[ID]float64, error) {
+	scores := make(map[ID]float64)
+
+	jsonBytes, err := ioutil.ReadFile(s.filePath)
+	if os.IsNotExist(err) {
+		return scores, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(jsonBytes, &scores); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
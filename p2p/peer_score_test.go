@@ -0,0 +1,36 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePeerScoreStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peer_score")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewFilePeerScoreStore(filepath.Join(dir, "peer_score.json"))
+
+	// missing file is not an error
+	scores, err := store.Load()
+	require.Nil(t, err)
+	assert.Empty(t, scores)
+
+	want := map[ID]float64{"peer1": 1.5, "peer2": -3}
+	require.Nil(t, store.Save(want))
+
+	scores, err = store.Load()
+	require.Nil(t, err)
+	assert.Equal(t, want, scores)
+
+	// corrupt file
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "peer_score.json"), []byte("{"), 0644))
+	_, err = store.Load()
+	assert.NotNil(t, err)
+}
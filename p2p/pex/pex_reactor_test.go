@@ -397,6 +397,7 @@ func (mp mockPeer) NodeInfo() p2p.NodeInfo {
 		ListenAddr: mp.addr.DialString(),
 	}
 }
+func (mockPeer) Meta() p2p.PeerMeta            { return p2p.PeerMeta{} }
 func (mockPeer) RemoteIP() net.IP              { return net.ParseIP("127.0.0.1") }
 func (mockPeer) Status() conn.ConnectionStatus { return conn.ConnectionStatus{} }
 func (mockPeer) Send(byte, []byte) bool        { return false }
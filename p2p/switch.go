@@ -3,6 +3,7 @@ package p2p
 import (
 	"fmt"
 	"math"
+	"net"
 	"sync"
 	"time"
 
@@ -25,6 +26,14 @@ const (
 	// ie. 3**10 = 16hrs
 	reconnectBackOffAttempts    = 10
 	reconnectBackOffBaseSeconds = 3
+
+	// subtracted from a peer's score for every message dropped by the
+	// connection rate limiter
+	rateLimitedPenalty = 1.0
+
+	// subtracted from a peer's score for every duplicate message, if
+	// dedup_penalize is set
+	duplicatePenalty = 0.1
 )
 
 // MConnConfig returns an MConnConfig with fields updated
@@ -35,6 +44,12 @@ func MConnConfig(cfg *config.P2PConfig) conn.MConnConfig {
 	mConfig.SendRate = cfg.SendRate
 	mConfig.RecvRate = cfg.RecvRate
 	mConfig.MaxPacketMsgPayloadSize = cfg.MaxPacketMsgPayloadSize
+	mConfig.MinPingInterval = cfg.MinPingInterval
+	mConfig.MaxPingInterval = cfg.MaxPingInterval
+	mConfig.RateLimit = conn.RateLimitConfig{
+		MessagesPerSecond: cfg.RecvMessageRate,
+		BurstSize:         cfg.RecvMessageBurst,
+	}
 	return mConfig
 }
 
@@ -72,6 +87,7 @@ type Switch struct {
 	peers        *PeerSet
 	dialing      *cmn.CMap
 	reconnecting *cmn.CMap
+	nodeInfoMtx  sync.RWMutex
 	nodeInfo     NodeInfo // our node info
 	nodeKey      *NodeKey // our node privkey
 	addrBook     AddrBook
@@ -83,6 +99,16 @@ type Switch struct {
 
 	rng *cmn.Rand // seed for randomizing dial times and orders
 
+	scoresMtx  sync.Mutex
+	scores     map[ID]float64 // peer reputation, see AdjustPeerScore
+	scoreStore PeerScoreStore
+
+	blacklistMtx   sync.Mutex
+	blacklist      map[string]time.Time // ban expiry by peer ID or IP, zero means forever
+	blacklistStore PeerBlacklistStore
+
+	dedup *MessageDeduplicator // nil if deduplication is disabled
+
 	metrics *Metrics
 }
 
@@ -103,6 +129,8 @@ func NewSwitch(
 		peers:         NewPeerSet(),
 		dialing:       cmn.NewCMap(),
 		reconnecting:  cmn.NewCMap(),
+		scores:        make(map[ID]float64),
+		blacklist:     make(map[string]time.Time),
 		metrics:       NopMetrics(),
 		transport:     transport,
 		filterTimeout: defaultFilterTimeout,
@@ -130,6 +158,24 @@ func SwitchPeerFilters(filters ...PeerFilterFunc) SwitchOption {
 	return func(sw *Switch) { sw.peerFilters = filters }
 }
 
+// SwitchPeerScoreStore sets the store used to persist peer scores across
+// restarts. Scores are loaded on start and saved on stop.
+func SwitchPeerScoreStore(store PeerScoreStore) SwitchOption {
+	return func(sw *Switch) { sw.scoreStore = store }
+}
+
+// SwitchPeerBlacklistStore sets the store used to persist the peer
+// blacklist. The blacklist is loaded on start and saved on every change.
+func SwitchPeerBlacklistStore(store PeerBlacklistStore) SwitchOption {
+	return func(sw *Switch) { sw.blacklistStore = store }
+}
+
+// SwitchMessageDeduplicator sets the MessageDeduplicator reactors use through
+// IsDuplicate.
+func SwitchMessageDeduplicator(dedup *MessageDeduplicator) SwitchOption {
+	return func(sw *Switch) { sw.dedup = dedup }
+}
+
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
@@ -170,14 +216,16 @@ func (sw *Switch) Reactor(name string) Reactor {
 }
 
 // SetNodeInfo sets the switch's NodeInfo for checking compatibility and handshaking with other nodes.
-// NOTE: Not goroutine safe.
 func (sw *Switch) SetNodeInfo(nodeInfo NodeInfo) {
+	sw.nodeInfoMtx.Lock()
+	defer sw.nodeInfoMtx.Unlock()
 	sw.nodeInfo = nodeInfo
 }
 
 // NodeInfo returns the switch's NodeInfo.
-// NOTE: Not goroutine safe.
 func (sw *Switch) NodeInfo() NodeInfo {
+	sw.nodeInfoMtx.RLock()
+	defer sw.nodeInfoMtx.RUnlock()
 	return sw.nodeInfo
 }
 
@@ -192,6 +240,32 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	// Restore peer scores
+	if sw.scoreStore != nil {
+		scores, err := sw.scoreStore.Load()
+		if err != nil {
+			return cmn.ErrorWrap(err, "failed to load peer scores")
+		}
+		sw.scoresMtx.Lock()
+		for id, score := range scores {
+			sw.scores[id] = score
+		}
+		sw.scoresMtx.Unlock()
+	}
+
+	// Restore peer blacklist
+	if sw.blacklistStore != nil {
+		blacklist, err := sw.blacklistStore.Load()
+		if err != nil {
+			return cmn.ErrorWrap(err, "failed to load peer blacklist")
+		}
+		sw.blacklistMtx.Lock()
+		for key, expiry := range blacklist {
+			sw.blacklist[key] = expiry
+		}
+		sw.blacklistMtx.Unlock()
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -221,6 +295,13 @@ func (sw *Switch) OnStop() {
 	for _, reactor := range sw.reactors {
 		reactor.Stop()
 	}
+
+	// Persist peer scores
+	if sw.scoreStore != nil {
+		if err := sw.scoreStore.Save(sw.peerScores()); err != nil {
+			sw.Logger.Error("Failed to save peer scores", "err", err)
+		}
+	}
 }
 
 //---------------------------------------------------------------------
@@ -328,7 +409,7 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 	start := time.Now()
 	sw.Logger.Info("Reconnecting to peer", "addr", addr)
 	for i := 0; i < reconnectAttempts; i++ {
-		if !sw.IsRunning() {
+		if !sw.IsRunning() || sw.isBlacklistedAddress(addr) {
 			return
 		}
 
@@ -351,7 +432,7 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 	sw.Logger.Error("Failed to reconnect to peer. Beginning exponential backoff",
 		"addr", addr, "elapsed", time.Since(start))
 	for i := 0; i < reconnectBackOffAttempts; i++ {
-		if !sw.IsRunning() {
+		if !sw.IsRunning() || sw.isBlacklistedAddress(addr) {
 			return
 		}
 
@@ -380,6 +461,176 @@ func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	}
 }
 
+// PeerScore returns the current score of the peer with the given ID.
+// Unknown peers have a score of zero.
+func (sw *Switch) PeerScore(id ID) float64 {
+	sw.scoresMtx.Lock()
+	defer sw.scoresMtx.Unlock()
+	return sw.scores[id]
+}
+
+// AdjustPeerScore adds delta (which may be negative) to the score of the peer
+// with the given ID.
+func (sw *Switch) AdjustPeerScore(id ID, delta float64) {
+	sw.scoresMtx.Lock()
+	defer sw.scoresMtx.Unlock()
+	sw.scores[id] += delta
+}
+
+// Blacklist bans the peer with the given ID for duration, or forever if
+// duration is 0, and disconnects it if it is connected. Blacklisted peers are
+// neither dialed nor accepted.
+func (sw *Switch) Blacklist(id ID, duration time.Duration) {
+	sw.ban(string(id), duration)
+	sw.Logger.Info("Blacklisted peer", "id", id, "duration", duration)
+
+	if peer := sw.peers.Get(id); peer != nil {
+		sw.stopAndRemovePeer(peer, ErrRejected{id: id, isBlacklisted: true})
+	}
+}
+
+// BlacklistIP bans the peers with the given IP like Blacklist.
+func (sw *Switch) BlacklistIP(ip net.IP, duration time.Duration) {
+	sw.ban(ip.String(), duration)
+	sw.Logger.Info("Blacklisted IP", "ip", ip, "duration", duration)
+
+	for _, peer := range sw.peers.List() {
+		if sw.isPeerIPBlacklisted(peer) {
+			sw.stopAndRemovePeer(peer, ErrRejected{id: peer.ID(), isBlacklisted: true})
+		}
+	}
+}
+
+// Unblacklist lifts the ban on the peer with the given ID.
+func (sw *Switch) Unblacklist(id ID) {
+	if sw.unban(string(id)) {
+		sw.Logger.Info("Unblacklisted peer", "id", id)
+	}
+}
+
+// UnblacklistIP lifts the ban on the peers with the given IP.
+func (sw *Switch) UnblacklistIP(ip net.IP) {
+	if sw.unban(ip.String()) {
+		sw.Logger.Info("Unblacklisted IP", "ip", ip)
+	}
+}
+
+// IsBlacklisted returns true if the peer with the given ID is currently
+// banned. Expired bans are removed.
+func (sw *Switch) IsBlacklisted(id ID) bool {
+	return sw.isBanned(string(id))
+}
+
+// IsIPBlacklisted returns true if the peers with the given IP are currently
+// banned. Expired bans are removed.
+func (sw *Switch) IsIPBlacklisted(ip net.IP) bool {
+	return ip != nil && sw.isBanned(ip.String())
+}
+
+// isPeerIPBlacklisted returns true if the IP of the peer is banned. The IP is
+// only resolved if some IPs are banned, as not every connection has one.
+func (sw *Switch) isPeerIPBlacklisted(p Peer) bool {
+	sw.blacklistMtx.Lock()
+	hasIPBans := false
+	for key := range sw.blacklist {
+		if net.ParseIP(key) != nil {
+			hasIPBans = true
+			break
+		}
+	}
+	sw.blacklistMtx.Unlock()
+
+	return hasIPBans && sw.IsIPBlacklisted(p.RemoteIP())
+}
+
+func (sw *Switch) isBlacklistedAddress(addr *NetAddress) bool {
+	return sw.IsBlacklisted(addr.ID) || sw.IsIPBlacklisted(addr.IP)
+}
+
+// ban bans the peer ID or IP key.
+func (sw *Switch) ban(key string, duration time.Duration) {
+	var expiry time.Time
+	if duration > 0 {
+		expiry = time.Now().Add(duration)
+	}
+
+	sw.blacklistMtx.Lock()
+	defer sw.blacklistMtx.Unlock()
+	sw.blacklist[key] = expiry
+	sw.saveBlacklist()
+}
+
+// unban lifts the ban on key, returning false if it wasn't banned.
+func (sw *Switch) unban(key string) bool {
+	sw.blacklistMtx.Lock()
+	defer sw.blacklistMtx.Unlock()
+
+	if _, ok := sw.blacklist[key]; !ok {
+		return false
+	}
+	delete(sw.blacklist, key)
+	sw.saveBlacklist()
+	return true
+}
+
+func (sw *Switch) isBanned(key string) bool {
+	sw.blacklistMtx.Lock()
+	defer sw.blacklistMtx.Unlock()
+
+	expiry, ok := sw.blacklist[key]
+	if !ok {
+		return false
+	}
+	if !expiry.IsZero() && time.Now().After(expiry) {
+		delete(sw.blacklist, key)
+		sw.saveBlacklist()
+		return false
+	}
+	return true
+}
+
+// saveBlacklist persists the blacklist. Assumes blacklistMtx is held.
+func (sw *Switch) saveBlacklist() {
+	if sw.blacklistStore == nil {
+		return
+	}
+	if err := sw.blacklistStore.Save(sw.blacklist); err != nil {
+		sw.Logger.Error("Failed to save peer blacklist", "err", err)
+	}
+}
+
+// IsDuplicate returns true if a message with the given ID (hash) was recently
+// received from any peer, in which case reactors should drop it. If
+// dedup_penalize is set, src's score is decremented for every duplicate.
+// Always returns false if deduplication is disabled.
+func (sw *Switch) IsDuplicate(src Peer, msgID []byte) bool {
+	if sw.dedup == nil || !sw.dedup.Seen(msgID) {
+		return false
+	}
+	if sw.config.DedupPenalize {
+		sw.AdjustPeerScore(src.ID(), -duplicatePenalty)
+	}
+	return true
+}
+
+// onPeerRateLimited penalises a peer whose message was dropped by the
+// connection rate limiter.
+func (sw *Switch) onPeerRateLimited(peer Peer, chID byte) {
+	sw.Logger.Debug("Peer exceeded rate limit", "peer", peer, "chID", chID)
+	sw.AdjustPeerScore(peer.ID(), -rateLimitedPenalty)
+}
+
+// peerScores returns a copy of all peer scores.
+func (sw *Switch) peerScores() map[ID]float64 {
+	sw.scoresMtx.Lock()
+	defer sw.scoresMtx.Unlock()
+	scores := make(map[ID]float64, len(sw.scores))
+	for id, score := range sw.scores {
+		scores[id] = score
+	}
+	return scores
+}
+
 //---------------------------------------------------------------------
 // Dialing
 
@@ -393,7 +644,7 @@ func (sw *Switch) DialPeersAsync(addrBook AddrBook, peers []string, persistent b
 		sw.Logger.Error("Error in peer's address", "err", err)
 	}
 
-	ourAddr := sw.nodeInfo.NetAddress()
+	ourAddr := sw.NodeInfo().NetAddress()
 
 	// TODO: this code feels like it's in the wrong place.
 	// The integration tests depend on the addrBook being saved
@@ -472,10 +723,11 @@ func (sw *Switch) IsDialingOrExistingAddress(addr *NetAddress) bool {
 func (sw *Switch) acceptRoutine() {
 	for {
 		p, err := sw.transport.Accept(peerConfig{
-			chDescs:      sw.chDescs,
-			onPeerError:  sw.StopPeerForError,
-			reactorsByCh: sw.reactorsByCh,
-			metrics:      sw.metrics,
+			chDescs:           sw.chDescs,
+			onPeerError:       sw.StopPeerForError,
+			onPeerRateLimited: sw.onPeerRateLimited,
+			reactorsByCh:      sw.reactorsByCh,
+			metrics:           sw.metrics,
 		})
 		if err != nil {
 			switch err.(type) {
@@ -557,6 +809,10 @@ func (sw *Switch) addOutboundPeerWithConfig(
 ) error {
 	sw.Logger.Info("Dialing peer", "address", addr)
 
+	if sw.isBlacklistedAddress(addr) {
+		return ErrRejected{id: addr.ID, isBlacklisted: true}
+	}
+
 	// XXX(xla): Remove the leakage of test concerns in implementation.
 	if cfg.TestDialFail {
 		go sw.reconnectToPeer(addr)
@@ -564,11 +820,12 @@ func (sw *Switch) addOutboundPeerWithConfig(
 	}
 
 	p, err := sw.transport.Dial(*addr, peerConfig{
-		chDescs:      sw.chDescs,
-		onPeerError:  sw.StopPeerForError,
-		persistent:   persistent,
-		reactorsByCh: sw.reactorsByCh,
-		metrics:      sw.metrics,
+		chDescs:           sw.chDescs,
+		onPeerError:       sw.StopPeerForError,
+		onPeerRateLimited: sw.onPeerRateLimited,
+		persistent:        persistent,
+		reactorsByCh:      sw.reactorsByCh,
+		metrics:           sw.metrics,
 	})
 	if err != nil {
 		switch e := err.(type) {
@@ -601,6 +858,10 @@ func (sw *Switch) addOutboundPeerWithConfig(
 }
 
 func (sw *Switch) filterPeer(p Peer) error {
+	if sw.IsBlacklisted(p.ID()) || sw.isPeerIPBlacklisted(p) {
+		return ErrRejected{id: p.ID(), isBlacklisted: true}
+	}
+
 	// Avoid duplicate
 	if sw.peers.Has(p.ID()) {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
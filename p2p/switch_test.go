@@ -6,6 +6,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"sync"
@@ -462,6 +464,143 @@ func TestSwitchReconnectsToPersistentPeer(t *testing.T) {
 	assert.EqualValues(2, npeers)
 }
 
+func TestSwitchPersistsPeerScores(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peer_score")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewFilePeerScoreStore(filepath.Join(dir, "peer_score.json"))
+
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchPeerScoreStore(store))
+	require.Nil(t, sw.Start())
+
+	id := ID("a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5")
+	sw.AdjustPeerScore(id, 3)
+	sw.AdjustPeerScore(id, -1)
+	assert.Equal(t, 2.0, sw.PeerScore(id))
+	sw.Stop()
+
+	// a new switch using the same store should pick up the saved scores
+	sw = MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchPeerScoreStore(store))
+	assert.Equal(t, 0.0, sw.PeerScore(id))
+	require.Nil(t, sw.Start())
+	defer sw.Stop()
+	assert.Equal(t, 2.0, sw.PeerScore(id))
+}
+
+func TestSwitchIsDuplicate(t *testing.T) {
+	p2pCfg := *cfg
+	p2pCfg.DedupPenalize = true
+	dedup := NewMessageDeduplicator(100, 0.001, time.Minute)
+
+	sw := MakeSwitch(&p2pCfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchMessageDeduplicator(dedup))
+	peer1, peer2 := newMockPeer(nil), newMockPeer(nil)
+
+	assert.False(t, sw.IsDuplicate(peer1, []byte("vote")))
+	assert.True(t, sw.IsDuplicate(peer2, []byte("vote")))
+	assert.Equal(t, 0.0, sw.PeerScore(peer1.ID()))
+	assert.Equal(t, -duplicatePenalty, sw.PeerScore(peer2.ID()))
+
+	// deduplication is disabled by default
+	sw = MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+	assert.False(t, sw.IsDuplicate(peer1, []byte("vote")))
+	assert.False(t, sw.IsDuplicate(peer1, []byte("vote")))
+}
+
+func TestSwitchBlacklist(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+	err := sw.Start()
+	require.Nil(t, err)
+	defer sw.Stop()
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	sw.Blacklist(rp.ID(), 0)
+	assert.True(t, sw.IsBlacklisted(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	if err, ok := err.(ErrRejected); ok {
+		assert.True(t, err.IsBlacklisted())
+	} else {
+		t.Errorf("expected ErrRejected, got %v", err)
+	}
+
+	sw.Unblacklist(rp.ID())
+	assert.False(t, sw.IsBlacklisted(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	require.Nil(t, err)
+	require.NotNil(t, sw.Peers().Get(rp.ID()))
+
+	// blacklisting a connected peer disconnects it
+	sw.Blacklist(rp.ID(), 0)
+	assert.Nil(t, sw.Peers().Get(rp.ID()))
+}
+
+func TestSwitchBlacklistIP(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+	err := sw.Start()
+	require.Nil(t, err)
+	defer sw.Stop()
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	require.Nil(t, err)
+	require.NotNil(t, sw.Peers().Get(rp.ID()))
+
+	// blacklisting the IP disconnects the peer, and refuses to dial it
+	sw.BlacklistIP(rp.Addr().IP, 0)
+	assert.True(t, sw.IsIPBlacklisted(rp.Addr().IP))
+	assert.False(t, sw.IsBlacklisted(rp.ID()))
+	assert.Nil(t, sw.Peers().Get(rp.ID()))
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	if err, ok := err.(ErrRejected); ok {
+		assert.True(t, err.IsBlacklisted())
+	} else {
+		t.Errorf("expected ErrRejected, got %v", err)
+	}
+
+	sw.UnblacklistIP(rp.Addr().IP)
+	assert.False(t, sw.IsIPBlacklisted(rp.Addr().IP))
+	err = sw.DialPeerWithAddress(rp.Addr(), false)
+	require.Nil(t, err)
+}
+
+func TestSwitchBlacklistExpires(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+
+	id := ID("a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5")
+	sw.Blacklist(id, 10*time.Millisecond)
+	assert.True(t, sw.IsBlacklisted(id))
+
+	time.Sleep(20 * time.Millisecond)
+	assert.False(t, sw.IsBlacklisted(id))
+}
+
+func TestSwitchPersistsBlacklist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peer_blacklist")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewFilePeerBlacklistStore(filepath.Join(dir, "peer_blacklist.json"))
+	id := ID("a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5")
+
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchPeerBlacklistStore(store))
+	sw.Blacklist(id, time.Hour)
+
+	sw = MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchPeerBlacklistStore(store))
+	assert.False(t, sw.IsBlacklisted(id))
+	require.Nil(t, sw.Start())
+	defer sw.Stop()
+	assert.True(t, sw.IsBlacklisted(id))
+}
+
 func TestSwitchFullConnectivity(t *testing.T) {
 	switches := MakeConnectedSwitches(cfg, 3, initSwitchFunc, Connect2Switches)
 	defer func() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -38,6 +39,7 @@ type accept struct {
 type peerConfig struct {
 	chDescs              []*conn.ChannelDescriptor
 	onPeerError          func(Peer, interface{})
+	onPeerRateLimited    func(Peer, byte)
 	outbound, persistent bool
 	reactorsByCh         map[byte]Reactor
 	metrics              *Metrics
@@ -103,12 +105,30 @@ func MultiplexTransportFilterTimeout(
 	return func(mt *MultiplexTransport) { mt.filterTimeout = timeout }
 }
 
+// MultiplexTransportCompressThreshold sets the size in bytes above which
+// messages are gzip compressed. Compression is only used with peers which,
+// like us, advertise CompressionGzip in their NodeInfo.
+func MultiplexTransportCompressThreshold(threshold int) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.compressThreshold = threshold }
+}
+
 // MultiplexTransportResolver sets the Resolver used for ip lokkups, defaults to
 // net.DefaultResolver.
 func MultiplexTransportResolver(resolver IPResolver) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.resolver = resolver }
 }
 
+type listenFunc func(addr NetAddress) (net.Listener, error)
+type dialFunc func(addr NetAddress, timeout time.Duration) (net.Conn, error)
+
+func listenTCP(addr NetAddress) (net.Listener, error) {
+	return net.Listen("tcp", addr.DialString())
+}
+
+func dialTCP(addr NetAddress, timeout time.Duration) (net.Conn, error) {
+	return addr.DialTimeout(timeout)
+}
+
 // MultiplexTransport accepts and dials tcp connections and upgrades them to
 // multiplexed peers.
 type MultiplexTransport struct {
@@ -124,10 +144,17 @@ type MultiplexTransport struct {
 	dialTimeout      time.Duration
 	filterTimeout    time.Duration
 	handshakeTimeout time.Duration
+	nodeInfoMtx      sync.RWMutex
 	nodeInfo         NodeInfo
 	nodeKey          NodeKey
 	resolver         IPResolver
 
+	compressThreshold int
+
+	// Protocol specific listen and dial, tcp unless configured otherwise.
+	listen listenFunc
+	dial   dialFunc
+
 	// TODO(xla): This config is still needed as we parameterise peerConn and
 	// peer currently. All relevant configuration should be refactored into options
 	// with sane defaults.
@@ -155,6 +182,8 @@ func NewMultiplexTransport(
 		nodeKey:          nodeKey,
 		conns:            NewConnSet(),
 		resolver:         net.DefaultResolver,
+		listen:           listenTCP,
+		dial:             dialTCP,
 	}
 }
 
@@ -181,7 +210,7 @@ func (mt *MultiplexTransport) Dial(
 	addr NetAddress,
 	cfg peerConfig,
 ) (Peer, error) {
-	c, err := addr.DialTimeout(mt.dialTimeout)
+	c, err := mt.dial(addr, mt.dialTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +232,21 @@ func (mt *MultiplexTransport) Dial(
 	return p, nil
 }
 
+// NodeInfo returns the NodeInfo sent to peers in the handshake.
+func (mt *MultiplexTransport) NodeInfo() NodeInfo {
+	mt.nodeInfoMtx.RLock()
+	defer mt.nodeInfoMtx.RUnlock()
+	return mt.nodeInfo
+}
+
+// SetNodeInfo replaces the NodeInfo sent to peers in the handshake, e.g. when
+// the external address of the node changed.
+func (mt *MultiplexTransport) SetNodeInfo(nodeInfo NodeInfo) {
+	mt.nodeInfoMtx.Lock()
+	defer mt.nodeInfoMtx.Unlock()
+	mt.nodeInfo = nodeInfo
+}
+
 // Close implements transportLifecycle.
 func (mt *MultiplexTransport) Close() error {
 	close(mt.closec)
@@ -216,7 +260,7 @@ func (mt *MultiplexTransport) Close() error {
 
 // Listen implements transportLifecycle.
 func (mt *MultiplexTransport) Listen(addr NetAddress) error {
-	ln, err := net.Listen("tcp", addr.DialString())
+	ln, err := mt.listen(addr)
 	if err != nil {
 		return err
 	}
@@ -341,7 +385,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
+	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.NodeInfo())
 	if err != nil {
 		return nil, nil, ErrRejected{
 			conn:          c,
@@ -373,7 +417,7 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	// Reject self.
-	if mt.nodeInfo.ID() == nodeInfo.ID() {
+	if mt.NodeInfo().ID() == nodeInfo.ID() {
 		return nil, nil, ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
@@ -382,7 +426,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
+	if err := mt.NodeInfo().CompatibleWith(nodeInfo); err != nil {
 		return nil, nil, ErrRejected{
 			conn:           c,
 			err:            err,
@@ -416,8 +460,13 @@ func (mt *MultiplexTransport) wrapPeer(
 		cfg.chDescs,
 		cfg.onPeerError,
 		PeerMetrics(cfg.metrics),
+		PeerOnRateLimited(cfg.onPeerRateLimited),
 	)
 
+	if compressionEnabled(mt.NodeInfo(), ni) {
+		PeerCodec(NewCompressedCodec(NopCodec(), mt.compressThreshold))(p)
+	}
+
 	// Wait for Peer to Stop so we can cleanup.
 	go func(c net.Conn) {
 		<-p.Quit()
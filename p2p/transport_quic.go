@@ -0,0 +1,216 @@
+package p2p
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	quic "github.com/lucas-clemente/quic-go"
+
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+const (
+	// ProtocolTCP and ProtocolQUIC are the supported P2P transport protocols.
+	ProtocolTCP  = "tcp"
+	ProtocolQUIC = "quic"
+
+	quicALPN        = "tendermint-p2p"
+	quicIdleTimeout = 60 * time.Second
+)
+
+// NewQUICTransport returns a MultiplexTransport which accepts and dials QUIC
+// connections instead of tcp ones.
+//
+// Each connection carries a single bidirectional QUIC stream, which is upgraded
+// exactly like a tcp connection: peers are authenticated by the
+// SecretConnection handshake with their NodeKey, so the TLS certificate QUIC
+// requires is an ephemeral self-signed one and is not verified.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+) *MultiplexTransport {
+	mt := NewMultiplexTransport(nodeInfo, nodeKey, mConfig)
+	mt.listen = listenQUIC
+	mt.dial = dialQUIC
+	return mt
+}
+
+func quicConfig(timeout time.Duration) *quic.Config {
+	return &quic.Config{
+		HandshakeTimeout: timeout,
+		IdleTimeout:      quicIdleTimeout,
+		KeepAlive:        true,
+	}
+}
+
+func listenQUIC(addr NetAddress) (net.Listener, error) {
+	tlsConfig, err := quicServerTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := quic.ListenAddr(
+		addr.DialString(),
+		tlsConfig,
+		quicConfig(defaultHandshakeTimeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ql := &quicListener{
+		ln:     ln,
+		connc:  make(chan net.Conn),
+		errc:   make(chan error, 1),
+		closec: make(chan struct{}),
+	}
+	go ql.acceptSessions()
+
+	return ql, nil
+}
+
+func dialQUIC(addr NetAddress, timeout time.Duration) (net.Conn, error) {
+	sess, err := quic.DialAddr(
+		addr.DialString(),
+		&tls.Config{
+			InsecureSkipVerify: true,
+			NextProtos:         []string{quicALPN},
+		},
+		quicConfig(timeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := sess.OpenStreamSync()
+	if err != nil {
+		_ = sess.Close()
+		return nil, err
+	}
+
+	return &quicConn{Stream: stream, sess: sess}, nil
+}
+
+// quicServerTLSConfig creates a TLS config with an ephemeral self-signed
+// certificate.
+func quicServerTLSConfig() (*tls.Config, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{certDER},
+			PrivateKey:  key,
+		}},
+		NextProtos: []string{quicALPN},
+	}, nil
+}
+
+//-----------------------------------------------------------------------------
+
+var errQUICListenerClosed = errors.New("quic listener closed")
+
+// quicConn is the first stream of a QUIC session exposed as a net.Conn.
+type quicConn struct {
+	quic.Stream
+	sess quic.Session
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+func (c *quicConn) LocalAddr() net.Addr  { return c.sess.LocalAddr() }
+func (c *quicConn) RemoteAddr() net.Addr { return c.sess.RemoteAddr() }
+
+// Close closes the stream and its session.
+func (c *quicConn) Close() error {
+	_ = c.Stream.Close()
+	return c.sess.Close()
+}
+
+// quicListener is a net.Listener returning the first stream of every accepted
+// QUIC session. Streams are awaited concurrently so that a session which never
+// opens one can't block others from being accepted.
+type quicListener struct {
+	ln quic.Listener
+
+	connc  chan net.Conn
+	errc   chan error
+	closec chan struct{}
+
+	closeOnce sync.Once
+}
+
+var _ net.Listener = (*quicListener)(nil)
+
+func (l *quicListener) acceptSessions() {
+	for {
+		sess, err := l.ln.Accept()
+		if err != nil {
+			l.errc <- err
+			return
+		}
+
+		go func(sess quic.Session) {
+			stream, err := sess.AcceptStream()
+			if err != nil {
+				_ = sess.Close()
+				return
+			}
+
+			c := &quicConn{Stream: stream, sess: sess}
+			select {
+			case l.connc <- c:
+			case <-l.closec:
+				_ = c.Close()
+			}
+		}(sess)
+	}
+}
+
+// Accept implements net.Listener.
+func (l *quicListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.connc:
+		return c, nil
+	case err := <-l.errc:
+		return nil, err
+	case <-l.closec:
+		return nil, &net.OpError{Op: "accept", Net: ProtocolQUIC, Err: errQUICListenerClosed}
+	}
+}
+
+// Close implements net.Listener.
+func (l *quicListener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.closec)
+		err = l.ln.Close()
+	})
+	return err
+}
+
+// Addr implements net.Listener.
+func (l *quicListener) Addr() net.Addr {
+	return l.ln.Addr()
+}
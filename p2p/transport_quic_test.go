@@ -0,0 +1,124 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+func newQUICTestTransport(name string) *MultiplexTransport {
+	pv := ed25519.GenPrivKey()
+	return NewQUICTransport(
+		testNodeInfo(PubKeyToID(pv.PubKey()), name),
+		NodeKey{PrivKey: pv},
+		conn.DefaultMConnConfig(),
+	)
+}
+
+func TestQUICTransportDialAccept(t *testing.T) {
+	mt := newQUICTestTransport("transport")
+
+	addr, err := NewNetAddressStringWithOptionalID("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mt.Listen(*addr); err != nil {
+		t.Fatal(err)
+	}
+	defer mt.Close()
+
+	dialer := newQUICTestTransport("dialer")
+
+	var (
+		errc  = make(chan error)
+		peerc = make(chan Peer, 1)
+	)
+
+	go func() {
+		addr, err := NewNetAddressStringWithOptionalID(mt.listener.Addr().String())
+		if err != nil {
+			errc <- err
+			return
+		}
+
+		p, err := dialer.Dial(*addr, peerConfig{})
+		if err != nil {
+			errc <- err
+			return
+		}
+
+		peerc <- p
+		close(errc)
+	}()
+
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := mt.Accept(peerConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have, want := p.ID(), dialer.nodeInfo.ID(); have != want {
+		t.Errorf("have %v, want %v", have, want)
+	}
+
+	dialed := <-peerc
+	if have, want := dialed.ID(), mt.nodeInfo.ID(); have != want {
+		t.Errorf("have %v, want %v", have, want)
+	}
+
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := dialed.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := dialed.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQUICListenerClose(t *testing.T) {
+	addr, err := NewNetAddressStringWithOptionalID("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ln, err := listenQUIC(*addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errc := make(chan error)
+	go func() {
+		_, err := ln.Accept()
+		errc <- err
+	}()
+
+	if err := ln.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Error("expected Accept to fail after Close")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Accept didn't return after Close")
+	}
+
+	// Closing twice is a noop.
+	if err := ln.Close(); err != nil {
+		t.Errorf("second close errored: %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package upnp
+
+// Just enough NAT-PMP (RFC 6886) to be able to forward ports on routers
+// which don't speak UPnP.
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	natpmpPort    = 5351
+	natpmpVersion = 0
+	natpmpTries   = 4 // 250ms, 500ms, 1s, 2s
+
+	natpmpOpExternalAddress = 0
+	natpmpOpMapUDP          = 1
+	natpmpOpMapTCP          = 2
+	natpmpOpResponse        = 128
+)
+
+type natpmpNAT struct {
+	gateway string // host:port
+}
+
+var _ NAT = (*natpmpNAT)(nil)
+
+// DiscoverNATPMP returns a NAT for the default gateway if it speaks NAT-PMP.
+func DiscoverNATPMP() (nat NAT, err error) {
+	gateway, err := defaultGateway()
+	if err != nil {
+		return nil, err
+	}
+	nat = newNATPMP(net.JoinHostPort(gateway.String(), strconv.Itoa(natpmpPort)))
+	if _, err := nat.GetExternalAddress(); err != nil {
+		return nil, fmt.Errorf("NAT-PMP discovery failed: %v", err)
+	}
+	return nat, nil
+}
+
+func newNATPMP(gateway string) *natpmpNAT {
+	return &natpmpNAT{gateway: gateway}
+}
+
+func (n *natpmpNAT) GetExternalAddress() (addr net.IP, err error) {
+	res, err := n.request([]byte{natpmpVersion, natpmpOpExternalAddress}, 12)
+	if err != nil {
+		return nil, err
+	}
+	return net.IPv4(res[8], res[9], res[10], res[11]), nil
+}
+
+func (n *natpmpNAT) AddPortMapping(protocol string, externalPort, internalPort int, description string, timeout int) (mappedExternalPort int, err error) {
+	op, err := natpmpMapOp(protocol)
+	if err != nil {
+		return 0, err
+	}
+
+	req := make([]byte, 12)
+	req[0] = natpmpVersion
+	req[1] = op
+	binary.BigEndian.PutUint16(req[4:], uint16(internalPort))
+	binary.BigEndian.PutUint16(req[6:], uint16(externalPort))
+	binary.BigEndian.PutUint32(req[8:], uint32(timeout))
+
+	res, err := n.request(req, 16)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint16(res[10:])), nil
+}
+
+func (n *natpmpNAT) DeletePortMapping(protocol string, externalPort, internalPort int) (err error) {
+	// A mapping is deleted by requesting it with a zero lifetime and a zero
+	// external port.
+	_, err = n.AddPortMapping(protocol, 0, internalPort, "", 0)
+	return err
+}
+
+func natpmpMapOp(protocol string) (byte, error) {
+	switch strings.ToLower(protocol) {
+	case "udp":
+		return natpmpOpMapUDP, nil
+	case "tcp":
+		return natpmpOpMapTCP, nil
+	default:
+		return 0, fmt.Errorf("Unknown protocol: %v", protocol)
+	}
+}
+
+// request sends req to the gateway, retrying with an exponential backoff, and
+// returns its response of resLen bytes.
+func (n *natpmpNAT) request(req []byte, resLen int) ([]byte, error) {
+	conn, err := net.Dial("udp4", n.gateway)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close() // nolint: errcheck
+
+	res := make([]byte, resLen)
+	timeout := 250 * time.Millisecond
+	for i := 0; i < natpmpTries; i++ {
+		if _, err = conn.Write(req); err != nil {
+			return nil, err
+		}
+		if err = conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+			return nil, err
+		}
+
+		var read int
+		read, err = conn.Read(res)
+		if err != nil {
+			if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
+				timeout *= 2
+				continue
+			}
+			return nil, err
+		}
+
+		if read < resLen || res[0] != natpmpVersion || res[1] != natpmpOpResponse+req[1] {
+			return nil, errors.New("invalid NAT-PMP response")
+		}
+		if code := binary.BigEndian.Uint16(res[2:]); code != 0 {
+			return nil, fmt.Errorf("NAT-PMP result code %d", code)
+		}
+		return res, nil
+	}
+	return nil, err
+}
+
+// defaultGateway reads the default route from /proc/net/route. On other
+// systems, or if no route is found, the gateway is assumed to be the first
+// address of our local /24.
+func defaultGateway() (net.IP, error) {
+	if f, err := os.Open("/proc/net/route"); err == nil {
+		defer f.Close() // nolint: errcheck
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			// Iface Destination Gateway ...
+			if len(fields) < 3 || fields[1] != "00000000" {
+				continue
+			}
+			gw, err := strconv.ParseUint(fields[2], 16, 32)
+			if err != nil || gw == 0 {
+				continue
+			}
+			// the address is in host (little endian) byte order
+			ip := make(net.IP, 4)
+			binary.LittleEndian.PutUint32(ip, uint32(gw))
+			return ip, nil
+		}
+	}
+
+	ourIP, err := localIPv4()
+	if err != nil {
+		return nil, err
+	}
+	return net.IPv4(ourIP[0], ourIP[1], ourIP[2], 1), nil
+}
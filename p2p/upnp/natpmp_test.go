@@ -0,0 +1,77 @@
+package upnp
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNATPMPGateway answers NAT-PMP requests with the given external IP and
+// maps every port to itself plus one.
+func fakeNATPMPGateway(t *testing.T, externalIP net.IP) (addr string, requests <-chan []byte) {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	reqc := make(chan []byte, 10)
+	go func() {
+		defer conn.Close() // nolint: errcheck
+		buf := make([]byte, 64)
+		for {
+			n, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			req := append([]byte(nil), buf[:n]...)
+			reqc <- req
+
+			var res []byte
+			switch req[1] {
+			case natpmpOpExternalAddress:
+				res = make([]byte, 12)
+				copy(res[8:], externalIP.To4())
+			default:
+				res = make([]byte, 16)
+				internalPort := binary.BigEndian.Uint16(req[4:])
+				binary.BigEndian.PutUint16(res[8:], internalPort)
+				binary.BigEndian.PutUint16(res[10:], binary.BigEndian.Uint16(req[6:])+1)
+				copy(res[12:], req[8:12])
+			}
+			res[1] = natpmpOpResponse + req[1]
+			if _, err := conn.WriteTo(res, from); err != nil {
+				return
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String(), reqc
+}
+
+func TestNATPMP(t *testing.T) {
+	externalIP := net.ParseIP("203.0.113.7")
+	gateway, requests := fakeNATPMPGateway(t, externalIP)
+	nat := newNATPMP(gateway)
+
+	ip, err := nat.GetExternalAddress()
+	require.Nil(t, err)
+	assert.True(t, externalIP.Equal(ip), "got %v", ip)
+	<-requests
+
+	port, err := nat.AddPortMapping("tcp", 26656, 26656, "test", 3600)
+	require.Nil(t, err)
+	assert.Equal(t, 26657, port)
+	req := <-requests
+	assert.EqualValues(t, natpmpOpMapTCP, req[1])
+	assert.EqualValues(t, 3600, binary.BigEndian.Uint32(req[8:]))
+
+	err = nat.DeletePortMapping("udp", 26657, 26656)
+	require.Nil(t, err)
+	req = <-requests
+	assert.EqualValues(t, natpmpOpMapUDP, req[1])
+	assert.EqualValues(t, 0, binary.BigEndian.Uint32(req[8:]))
+
+	_, err = nat.AddPortMapping("sctp", 26656, 26656, "test", 3600)
+	assert.NotNil(t, err)
+}
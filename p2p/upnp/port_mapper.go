@@ -0,0 +1,162 @@
+package upnp
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// mappings are leased for twice the refresh interval so that they survive
+	// a failed refresh
+	portMappingRefresh  = 30 * time.Minute
+	portMappingLifetime = 2 * portMappingRefresh
+
+	portMappingDescription = "Tendermint"
+)
+
+// PortMapper opens a port on the router with UPnP, or NAT-PMP if the router
+// doesn't support UPnP, so that peers can dial in from outside the local
+// network. The mapping is refreshed periodically and deleted on stop.
+type PortMapper struct {
+	cmn.BaseService
+
+	protocol string
+	port     int
+	discover func() (NAT, error)
+
+	mtx             sync.Mutex
+	nat             NAT
+	externalIP      net.IP
+	externalPort    int
+	onAddressChange func(ip net.IP, port int)
+}
+
+// NewPortMapper returns a PortMapper forwarding the external port to the same
+// internal port. protocol is either "tcp" or "udp".
+func NewPortMapper(protocol string, port int) *PortMapper {
+	pm := &PortMapper{
+		protocol: protocol,
+		port:     port,
+		discover: discoverNAT,
+	}
+	pm.BaseService = *cmn.NewBaseService(nil, "PortMapper", pm)
+	return pm
+}
+
+func discoverNAT() (NAT, error) {
+	nat, upnpErr := Discover()
+	if upnpErr == nil {
+		return nat, nil
+	}
+	nat, natpmpErr := DiscoverNATPMP()
+	if natpmpErr == nil {
+		return nat, nil
+	}
+	return nil, fmt.Errorf("no UPnP (%v) or NAT-PMP (%v) router found", upnpErr, natpmpErr)
+}
+
+// OnStart implements BaseService. It discovers the router and maps the port.
+func (pm *PortMapper) OnStart() error {
+	nat, err := pm.discover()
+	if err != nil {
+		return err
+	}
+
+	pm.mtx.Lock()
+	pm.nat = nat
+	pm.mtx.Unlock()
+
+	if err := pm.mapPort(); err != nil {
+		return err
+	}
+
+	go pm.refreshRoutine()
+
+	return nil
+}
+
+// OnStop implements BaseService. It deletes the port mapping.
+func (pm *PortMapper) OnStop() {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+
+	if err := pm.nat.DeletePortMapping(pm.protocol, pm.externalPort, pm.port); err != nil {
+		pm.Logger.Error("Failed to delete port mapping", "err", err)
+	}
+}
+
+// ExternalAddress returns the external IP and port peers can dial.
+func (pm *PortMapper) ExternalAddress() (net.IP, int) {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+	return pm.externalIP, pm.externalPort
+}
+
+// SetOnAddressChange sets a callback invoked when a refresh of the mapping
+// changes the external address.
+func (pm *PortMapper) SetOnAddressChange(cb func(ip net.IP, port int)) {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+	pm.onAddressChange = cb
+}
+
+func (pm *PortMapper) mapPort() error {
+	pm.mtx.Lock()
+
+	ip, err := pm.nat.GetExternalAddress()
+	if err != nil {
+		pm.mtx.Unlock()
+		return fmt.Errorf("External address error: %v", err)
+	}
+
+	port, err := pm.nat.AddPortMapping(
+		pm.protocol,
+		pm.port,
+		pm.port,
+		portMappingDescription,
+		int(portMappingLifetime/time.Second),
+	)
+	if err != nil {
+		pm.mtx.Unlock()
+		return fmt.Errorf("Port mapping error: %v", err)
+	}
+
+	changed := !ip.Equal(pm.externalIP) || port != pm.externalPort
+	refreshed := pm.externalIP != nil
+	if changed {
+		pm.Logger.Info("Mapped port on router", "protocol", pm.protocol,
+			"externalIP", ip, "externalPort", port, "internalPort", pm.port)
+	}
+	pm.externalIP = ip
+	pm.externalPort = port
+	cb := pm.onAddressChange
+	pm.mtx.Unlock()
+
+	// the callback is invoked without holding the lock so that it can call
+	// ExternalAddress
+	if changed && refreshed && cb != nil {
+		cb(ip, port)
+	}
+
+	return nil
+}
+
+func (pm *PortMapper) refreshRoutine() {
+	ticker := time.NewTicker(portMappingRefresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := pm.mapPort(); err != nil {
+				pm.Logger.Error("Failed to refresh port mapping", "err", err)
+			}
+		case <-pm.Quit():
+			return
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package upnp
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNAT maps every port to itself on the given external IP.
+type fakeNAT struct {
+	mtx        sync.Mutex
+	externalIP net.IP
+	mappings   map[int]int
+}
+
+func newFakeNAT(externalIP net.IP) *fakeNAT {
+	return &fakeNAT{externalIP: externalIP, mappings: make(map[int]int)}
+}
+
+func (n *fakeNAT) setExternalIP(ip net.IP) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.externalIP = ip
+}
+
+func (n *fakeNAT) GetExternalAddress() (net.IP, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.externalIP, nil
+}
+
+func (n *fakeNAT) AddPortMapping(protocol string, externalPort, internalPort int,
+	description string, timeout int) (int, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.mappings[externalPort] = internalPort
+	return externalPort, nil
+}
+
+func (n *fakeNAT) DeletePortMapping(protocol string, externalPort, internalPort int) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	delete(n.mappings, externalPort)
+	return nil
+}
+
+func TestPortMapper(t *testing.T) {
+	nat := newFakeNAT(net.ParseIP("1.2.3.4"))
+
+	pm := NewPortMapper("tcp", 26656)
+	pm.discover = func() (NAT, error) { return nat, nil }
+
+	type address struct {
+		ip   net.IP
+		port int
+	}
+	changes := make(chan address, 1)
+	pm.SetOnAddressChange(func(ip net.IP, port int) {
+		changes <- address{ip, port}
+	})
+
+	require.Nil(t, pm.Start())
+
+	ip, port := pm.ExternalAddress()
+	assert.Equal(t, "1.2.3.4", ip.String())
+	assert.Equal(t, 26656, port)
+	assert.Equal(t, map[int]int{26656: 26656}, nat.mappings)
+	assert.Len(t, changes, 0, "the first mapping isn't a change")
+
+	// a refresh keeping the address doesn't notify
+	require.Nil(t, pm.mapPort())
+	assert.Len(t, changes, 0)
+
+	// a refresh with a new external IP does
+	nat.setExternalIP(net.ParseIP("5.6.7.8"))
+	require.Nil(t, pm.mapPort())
+	change := <-changes
+	assert.Equal(t, "5.6.7.8", change.ip.String())
+	assert.Equal(t, 26656, change.port)
+
+	ip, _ = pm.ExternalAddress()
+	assert.Equal(t, "5.6.7.8", ip.String())
+
+	require.Nil(t, pm.Stop())
+	assert.Empty(t, nat.mappings)
+}
@@ -0,0 +1,375 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	amino "github.com/tendermint/go-amino"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	core_grpc "github.com/tendermint/tendermint/rpc/grpc"
+	"github.com/tendermint/tendermint/types"
+)
+
+/*
+GRPC is a Client implementation that communicates with a tendermint node
+over gRPC (see rpc/grpc/types.proto), as an alternative to HTTP. The results
+are the same as the ones of HTTP, so switching between them only takes
+changing the constructor.
+
+Stopping the (started) client cancels its subscriptions, and closes the
+connection.
+*/
+type GRPC struct {
+	cmn.BaseService
+	cdc  *amino.Codec
+	conn *grpc.ClientConn
+	api  core_grpc.CoreAPIClient
+
+	mtx           sync.Mutex
+	subscriptions map[string]grpcSubscription // by query
+}
+
+type grpcSubscription struct {
+	cancel context.CancelFunc
+	done   chan struct{} // closed when the event listener returned
+}
+
+// NewGRPC takes a remote endpoint in the form tcp://<host>:<port>, the
+// grpc_laddr of the node.
+func NewGRPC(remote string) *GRPC {
+	api, conn := core_grpc.StartGRPCCoreClient(remote)
+	cdc := amino.NewCodec()
+	ctypes.RegisterAmino(cdc)
+
+	c := &GRPC{
+		cdc:           cdc,
+		conn:          conn,
+		api:           api,
+		subscriptions: make(map[string]grpcSubscription),
+	}
+	c.BaseService = *cmn.NewBaseService(nil, "GRPC", c)
+	return c
+}
+
+var (
+	_ Client        = (*GRPC)(nil)
+	_ NetworkClient = (*GRPC)(nil)
+	_ EventsClient  = (*GRPC)(nil)
+)
+
+// OnStop cancels the subscriptions, and closes the connection.
+func (c *GRPC) OnStop() {
+	c.UnsubscribeAll(context.Background(), "") // nolint: errcheck
+	if err := c.conn.Close(); err != nil {
+		c.Logger.Error("failed to close gRPC connection", "err", err)
+	}
+}
+
+func (c *GRPC) Status() (*ctypes.ResultStatus, error) {
+	result := new(ctypes.ResultStatus)
+	res, err := c.api.Status(context.Background(), &core_grpc.RequestEmpty{})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "Status")
+	}
+	return result, nil
+}
+
+func (c *GRPC) NetInfo() (*ctypes.ResultNetInfo, error) {
+	result := new(ctypes.ResultNetInfo)
+	res, err := c.api.NetInfo(context.Background(), &core_grpc.RequestEmpty{})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "NetInfo")
+	}
+	return result, nil
+}
+
+func (c *GRPC) DumpConsensusState() (*ctypes.ResultDumpConsensusState, error) {
+	result := new(ctypes.ResultDumpConsensusState)
+	res, err := c.api.DumpConsensusState(context.Background(), &core_grpc.RequestEmpty{})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "DumpConsensusState")
+	}
+	return result, nil
+}
+
+func (c *GRPC) ConsensusState() (*ctypes.ResultConsensusState, error) {
+	result := new(ctypes.ResultConsensusState)
+	res, err := c.api.ConsensusState(context.Background(), &core_grpc.RequestEmpty{})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "ConsensusState")
+	}
+	return result, nil
+}
+
+func (c *GRPC) Health() (*ctypes.ResultHealth, error) {
+	result := new(ctypes.ResultHealth)
+	res, err := c.api.Health(context.Background(), &core_grpc.RequestEmpty{})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "Health")
+	}
+	return result, nil
+}
+
+func (c *GRPC) ABCIInfo() (*ctypes.ResultABCIInfo, error) {
+	result := new(ctypes.ResultABCIInfo)
+	res, err := c.api.ABCIInfo(context.Background(), &core_grpc.RequestEmpty{})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "ABCIInfo")
+	}
+	return result, nil
+}
+
+func (c *GRPC) ABCIQuery(path string, data cmn.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(path, data, DefaultABCIQueryOptions)
+}
+
+func (c *GRPC) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	result := new(ctypes.ResultABCIQuery)
+	res, err := c.api.ABCIQuery(context.Background(),
+		&core_grpc.RequestABCIQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "ABCIQuery")
+	}
+	return result, nil
+}
+
+func (c *GRPC) ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	result := new(ctypes.ResultABCIProof)
+	res, err := c.api.ABCIProof(context.Background(),
+		&core_grpc.RequestABCIProof{Path: path, Key: key, Height: height})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "ABCIProof")
+	}
+	return result, nil
+}
+
+func (c *GRPC) BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	result := new(ctypes.ResultBroadcastTxCommit)
+	res, err := c.api.BroadcastTxCommit(context.Background(), &core_grpc.RequestBroadcastTx{Tx: tx})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "BroadcastTxCommit")
+	}
+	return result, nil
+}
+
+func (c *GRPC) BroadcastTxAsync(tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	result := new(ctypes.ResultBroadcastTx)
+	res, err := c.api.BroadcastTxAsync(context.Background(), &core_grpc.RequestBroadcastTx{Tx: tx})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "BroadcastTxAsync")
+	}
+	return result, nil
+}
+
+func (c *GRPC) BroadcastTxSync(tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	result := new(ctypes.ResultBroadcastTx)
+	res, err := c.api.BroadcastTxSync(context.Background(), &core_grpc.RequestBroadcastTx{Tx: tx})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "BroadcastTxSync")
+	}
+	return result, nil
+}
+
+func (c *GRPC) Genesis() (*ctypes.ResultGenesis, error) {
+	result := new(ctypes.ResultGenesis)
+	res, err := c.api.Genesis(context.Background(), &core_grpc.RequestEmpty{})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "Genesis")
+	}
+	return result, nil
+}
+
+func (c *GRPC) BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
+	result := new(ctypes.ResultBlockchainInfo)
+	res, err := c.api.BlockchainInfo(context.Background(),
+		&core_grpc.RequestBlockchainInfo{MinHeight: minHeight, MaxHeight: maxHeight})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "BlockchainInfo")
+	}
+	return result, nil
+}
+
+func (c *GRPC) Block(height *int64) (*ctypes.ResultBlock, error) {
+	result := new(ctypes.ResultBlock)
+	res, err := c.api.Block(context.Background(), requestHeight(height))
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "Block")
+	}
+	return result, nil
+}
+
+func (c *GRPC) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	result := new(ctypes.ResultBlockResults)
+	res, err := c.api.BlockResults(context.Background(), requestHeight(height))
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "BlockResults")
+	}
+	return result, nil
+}
+
+func (c *GRPC) Commit(height *int64) (*ctypes.ResultCommit, error) {
+	result := new(ctypes.ResultCommit)
+	res, err := c.api.Commit(context.Background(), requestHeight(height))
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "Commit")
+	}
+	return result, nil
+}
+
+func (c *GRPC) Validators(height *int64) (*ctypes.ResultValidators, error) {
+	result := new(ctypes.ResultValidators)
+	res, err := c.api.Validators(context.Background(), requestHeight(height))
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "Validators")
+	}
+	return result, nil
+}
+
+func (c *GRPC) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	result := new(ctypes.ResultTx)
+	res, err := c.api.Tx(context.Background(), &core_grpc.RequestTx{Hash: hash, Prove: prove})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "Tx")
+	}
+	return result, nil
+}
+
+func (c *GRPC) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+	result := new(ctypes.ResultTxSearch)
+	res, err := c.api.TxSearch(context.Background(),
+		&core_grpc.RequestTxSearch{Query: query, Prove: prove, Page: int32(page), PerPage: int32(perPage)})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "TxSearch")
+	}
+	return result, nil
+}
+
+func (c *GRPC) BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	result := new(ctypes.ResultBlockSearch)
+	res, err := c.api.BlockSearch(context.Background(),
+		&core_grpc.RequestBlockSearch{Query: query, Page: int32(page), PerPage: int32(perPage)})
+	if err = c.unmarshal(res, err, result); err != nil {
+		return nil, errors.Wrap(err, "BlockSearch")
+	}
+	return result, nil
+}
+
+// unmarshal decodes the result of a call, unless it failed with err.
+func (c *GRPC) unmarshal(res *core_grpc.ResponseResult, err error, result interface{}) error {
+	if err != nil {
+		return err
+	}
+	return c.cdc.UnmarshalJSON(res.Result, result)
+}
+
+func requestHeight(height *int64) *core_grpc.RequestHeight {
+	if height == nil {
+		return &core_grpc.RequestHeight{}
+	}
+	return &core_grpc.RequestHeight{Height: *height}
+}
+
+/** event stuff here... **/
+
+func (c *GRPC) Subscribe(ctx context.Context, subscriber string, query tmpubsub.Query, out chan<- interface{}) error {
+	q := query.String()
+
+	// the stream outlives ctx, which only bounds the subscription
+	subCtx, cancel := context.WithCancel(context.Background())
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-stop:
+		}
+	}()
+	stream, err := c.api.Subscribe(subCtx, &core_grpc.RequestSubscribe{Query: q})
+	if err == nil {
+		// the first message confirms the subscription
+		_, err = stream.Recv()
+	}
+	close(stop)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	done := make(chan struct{})
+	c.mtx.Lock()
+	// subscriber param is ignored because Tendermint will override it with
+	// remote IP anyway.
+	if _, ok := c.subscriptions[q]; ok {
+		c.mtx.Unlock()
+		cancel()
+		return tmpubsub.ErrAlreadySubscribed
+	}
+	c.subscriptions[q] = grpcSubscription{cancel: cancel, done: done}
+	c.mtx.Unlock()
+
+	go c.eventListener(subCtx, stream, out, done)
+	return nil
+}
+
+func (c *GRPC) Unsubscribe(ctx context.Context, subscriber string, query tmpubsub.Query) error {
+	q := query.String()
+
+	c.mtx.Lock()
+	sub, ok := c.subscriptions[q]
+	delete(c.subscriptions, q)
+	c.mtx.Unlock()
+	if !ok {
+		return tmpubsub.ErrSubscriptionNotFound
+	}
+
+	// the event listener closes out
+	sub.cancel()
+	<-sub.done
+	return nil
+}
+
+func (c *GRPC) UnsubscribeAll(ctx context.Context, subscriber string) error {
+	c.mtx.Lock()
+	subscriptions := c.subscriptions
+	c.subscriptions = make(map[string]grpcSubscription)
+	c.mtx.Unlock()
+
+	for _, sub := range subscriptions {
+		sub.cancel()
+		<-sub.done
+	}
+	return nil
+}
+
+// eventListener pushes the events of stream to out, until ctx is done or the
+// stream fails, and then closes out.
+func (c *GRPC) eventListener(ctx context.Context, stream core_grpc.CoreAPI_SubscribeClient,
+	out chan<- interface{}, done chan struct{}) {
+	defer close(done)
+	defer close(out)
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				c.Logger.Error("gRPC subscription failed", "err", err)
+			}
+			return
+		}
+		result := new(ctypes.ResultEvent)
+		if err := c.cdc.UnmarshalJSON(res.Result, result); err != nil {
+			c.Logger.Error("failed to unmarshal event", "err", err)
+			continue
+		}
+		select {
+		case out <- result.Data:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -83,6 +84,17 @@ func (c *HTTP) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts ABCIQue
 	return result, nil
 }
 
+func (c *HTTP) ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	result := new(ctypes.ResultABCIProof)
+	_, err := c.rpc.Call("abci_proof",
+		map[string]interface{}{"path": path, "key": key, "height": height},
+		result)
+	if err != nil {
+		return nil, errors.Wrap(err, "ABCIProof")
+	}
+	return result, nil
+}
+
 func (c *HTTP) BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	result := new(ctypes.ResultBroadcastTxCommit)
 	_, err := c.rpc.Call("broadcast_tx_commit", map[string]interface{}{"tx": tx}, result)
@@ -183,6 +195,58 @@ func (c *HTTP) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
 	return result, nil
 }
 
+// BlockResultsPart is a part of the results of a block received by
+// BlockResultsStream, or the error which ended the stream early.
+type BlockResultsPart struct {
+	*ctypes.ResultBlockResults
+	Err error
+}
+
+// BlockResultsStream requests the results of the block at height in chunks
+// (see ResultBlockResults.StreamParts), and sends the parts on the returned
+// channel as they are received. The channel is closed after the last part.
+// If the stream fails before, the last part sent holds the error instead of
+// results. It is also closed early if ctx is done.
+func (c *HTTP) BlockResultsStream(ctx context.Context, height *int64) (<-chan BlockResultsPart, error) {
+	stream, err := c.rpc.CallStream(ctx, "block_results", map[string]interface{}{"height": height})
+	if err != nil {
+		return nil, errors.Wrap(err, "Block Result Stream")
+	}
+	// the first part holds the error, if any
+	first := new(ctypes.ResultBlockResults)
+	if err := stream.Next(first); err != nil {
+		stream.Close() // nolint: errcheck
+		return nil, errors.Wrap(err, "Block Result Stream")
+	}
+
+	parts := make(chan BlockResultsPart)
+	go func() {
+		defer close(parts)
+		defer stream.Close() // nolint: errcheck
+		part := BlockResultsPart{ResultBlockResults: first}
+		for {
+			select {
+			case parts <- part:
+			case <-ctx.Done():
+				return
+			}
+			if part.Err != nil {
+				return
+			}
+			result := new(ctypes.ResultBlockResults)
+			err := stream.Next(result)
+			if err == io.EOF {
+				return
+			}
+			part = BlockResultsPart{ResultBlockResults: result}
+			if err != nil {
+				part = BlockResultsPart{Err: errors.Wrap(err, "Block Result Stream")}
+			}
+		}
+	}()
+	return parts, nil
+}
+
 func (c *HTTP) Commit(height *int64) (*ctypes.ResultCommit, error) {
 	result := new(ctypes.ResultCommit)
 	_, err := c.rpc.Call("commit", map[string]interface{}{"height": height}, result)
@@ -220,6 +284,20 @@ func (c *HTTP) TxSearch(query string, prove bool, page, perPage int) (*ctypes.Re
 	return result, nil
 }
 
+func (c *HTTP) BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	result := new(ctypes.ResultBlockSearch)
+	params := map[string]interface{}{
+		"query":    query,
+		"page":     page,
+		"per_page": perPage,
+	}
+	_, err := c.rpc.Call("block_search", params, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "BlockSearch")
+	}
+	return result, nil
+}
+
 func (c *HTTP) Validators(height *int64) (*ctypes.ResultValidators, error) {
 	result := new(ctypes.ResultValidators)
 	_, err := c.rpc.Call("validators", map[string]interface{}{"height": height}, result)
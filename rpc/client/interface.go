@@ -35,6 +35,7 @@ type ABCIClient interface {
 	ABCIQuery(path string, data cmn.HexBytes) (*ctypes.ResultABCIQuery, error)
 	ABCIQueryWithOptions(path string, data cmn.HexBytes,
 		opts ABCIQueryOptions) (*ctypes.ResultABCIQuery, error)
+	ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error)
 
 	// Writing to abci app
 	BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
@@ -51,6 +52,7 @@ type SignClient interface {
 	Validators(height *int64) (*ctypes.ResultValidators, error)
 	Tx(hash []byte, prove bool) (*ctypes.ResultTx, error)
 	TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error)
+	BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error)
 }
 
 // HistoryClient shows us data from genesis to now in large chunks.
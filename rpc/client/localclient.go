@@ -64,6 +64,10 @@ func (Local) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts ABCIQuery
 	return core.ABCIQuery(path, data, opts.Height, opts.Prove)
 }
 
+func (Local) ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	return core.ABCIProof(path, key, height)
+}
+
 func (Local) BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	return core.BroadcastTxCommit(tx)
 }
@@ -132,6 +136,10 @@ func (Local) TxSearch(query string, prove bool, page, perPage int) (*ctypes.Resu
 	return core.TxSearch(query, prove, page, perPage)
 }
 
+func (Local) BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	return core.BlockSearch(query, page, perPage)
+}
+
 func (c *Local) Subscribe(ctx context.Context, subscriber string, query tmpubsub.Query, out chan<- interface{}) error {
 	return c.EventBus.Subscribe(ctx, subscriber, query, out)
 }
@@ -40,6 +40,14 @@ func (a ABCIApp) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts clien
 	return &ctypes.ResultABCIQuery{q}, nil
 }
 
+func (a ABCIApp) ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	res, err := a.ABCIQueryWithOptions(path, key, client.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewResultABCIProof(res.Response)
+}
+
 // NOTE: Caller should call a.App.Commit() separately,
 // this function does not actually wait for a commit.
 // TODO: Make it wait for a commit and set res.Height appropriately.
@@ -103,6 +111,14 @@ func (m ABCIMock) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts clie
 	return &ctypes.ResultABCIQuery{resQuery}, nil
 }
 
+func (m ABCIMock) ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	res, err := m.ABCIQueryWithOptions(path, key, client.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewResultABCIProof(res.Response)
+}
+
 func (m ABCIMock) BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	res, err := m.BroadcastCommit.GetResponse(tx)
 	if err != nil {
@@ -177,6 +193,17 @@ func (r *ABCIRecorder) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts
 	return res, err
 }
 
+func (r *ABCIRecorder) ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	res, err := r.Client.ABCIProof(path, key, height)
+	r.addCall(Call{
+		Name:     "abci_proof",
+		Args:     QueryArgs{path, key, height, true},
+		Response: res,
+		Error:    err,
+	})
+	return res, err
+}
+
 func (r *ABCIRecorder) BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	res, err := r.Client.BroadcastTxCommit(tx)
 	r.addCall(Call{
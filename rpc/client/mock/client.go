@@ -91,6 +91,10 @@ func (c Client) ABCIQueryWithOptions(path string, data cmn.HexBytes, opts client
 	return core.ABCIQuery(path, data, opts.Height, opts.Prove)
 }
 
+func (c Client) ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	return core.ABCIProof(path, key, height)
+}
+
 func (c Client) BroadcastTxCommit(tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	return core.BroadcastTxCommit(tx)
 }
@@ -1,6 +1,7 @@
 package client_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	"github.com/tendermint/tendermint/rpc/test"
 	"github.com/tendermint/tendermint/types"
 )
@@ -21,6 +23,11 @@ func getHTTPClient() *client.HTTP {
 	return client.NewHTTP(rpcAddr, "/websocket")
 }
 
+func getGRPCClient() *client.GRPC {
+	grpcAddr := rpctest.GetConfig().RPC.GRPCListenAddress
+	return client.NewGRPC(grpcAddr)
+}
+
 func getLocalClient() *client.Local {
 	return client.NewLocal(node)
 }
@@ -29,6 +36,7 @@ func getLocalClient() *client.Local {
 func GetClients() []client.Client {
 	return []client.Client{
 		getHTTPClient(),
+		getGRPCClient(),
 		getLocalClient(),
 	}
 }
@@ -48,6 +56,16 @@ func TestCorsEnabled(t *testing.T) {
 	assert.Equal(t, resp.Header.Get("Access-Control-Allow-Origin"), origin)
 }
 
+func TestVersionedPaths(t *testing.T) {
+	remote := strings.Replace(rpctest.GetConfig().RPC.ListenAddress, "tcp", "http", -1)
+
+	resp, err := http.Get(remote + "/v1/status")
+	require.Nil(t, err, "%+v", err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Deprecation"))
+}
+
 // Make sure status is correct (we connect properly)
 func TestStatus(t *testing.T) {
 	for i, c := range GetClients() {
@@ -151,6 +169,10 @@ func TestABCIQuery(t *testing.T) {
 		if assert.Nil(t, err) && assert.True(t, qres.IsOK()) {
 			assert.EqualValues(t, v, qres.Value)
 		}
+
+		// the kvstore doesn't prove its state
+		_, err = c.ABCIProof("/key", k, 0)
+		assert.Error(t, err, "%d", i)
 	}
 }
 
@@ -246,6 +268,36 @@ func TestAppCalls(t *testing.T) {
 	}
 }
 
+func TestBlockResultsStream(t *testing.T) {
+	c := getHTTPClient()
+
+	_, _, tx := MakeTxKV()
+	bres, err := c.BroadcastTxCommit(tx)
+	require.Nil(t, err, "%+v", err)
+	require.True(t, bres.DeliverTx.IsOK())
+
+	results, err := c.BlockResultsStream(context.Background(), &bres.Height)
+	require.Nil(t, err, "%+v", err)
+	var parts []*ctypes.ResultBlockResults
+	for part := range results {
+		require.Nil(t, part.Err, "%+v", part.Err)
+		assert.Equal(t, bres.Height, part.Height)
+		parts = append(parts, part.ResultBlockResults)
+	}
+	// BeginBlock, DeliverTx, EndBlock
+	require.Equal(t, 3, len(parts))
+	assert.NotNil(t, parts[0].Results.BeginBlock)
+	if assert.Equal(t, 1, len(parts[1].Results.DeliverTx)) {
+		assert.EqualValues(t, 0, parts[1].Results.DeliverTx[0].Code)
+	}
+	assert.NotNil(t, parts[2].Results.EndBlock)
+
+	// errors are returned right away
+	h := bres.Height + 100
+	_, err = c.BlockResultsStream(context.Background(), &h)
+	assert.NotNil(t, err)
+}
+
 func TestBroadcastTxSync(t *testing.T) {
 	require := require.New(t)
 
@@ -355,6 +407,8 @@ func TestTxSearch(t *testing.T) {
 		result, err := c.TxSearch(fmt.Sprintf("tx.hash='%v'", txHash), true, 1, 30)
 		require.Nil(t, err, "%+v", err)
 		require.Len(t, result.Txs, 1)
+		assert.Equal(t, 1, result.TotalCount)
+		assert.Equal(t, 1, result.TotalPages)
 
 		ptx := result.Txs[0]
 		assert.EqualValues(t, txHeight, ptx.Height)
@@ -392,5 +446,19 @@ func TestTxSearch(t *testing.T) {
 		if len(result.Txs) == 0 {
 			t.Fatal("expected a lot of transactions")
 		}
+
+		// query for the block of the tx
+		blocks, err := c.BlockSearch(fmt.Sprintf("tx.hash='%v'", txHash), 1, 30)
+		require.Nil(t, err, "%+v", err)
+		require.Len(t, blocks.Blocks, 1)
+		assert.EqualValues(t, txHeight, blocks.Blocks[0].Block.Height)
+		assert.Equal(t, 1, blocks.TotalCount)
+		assert.Equal(t, 1, blocks.TotalPages)
+
+		// one block per page
+		blocks, err = c.BlockSearch("app.creator='Cosmoshi Netowoko'", 1, 1)
+		require.Nil(t, err, "%+v", err)
+		require.Len(t, blocks.Blocks, 1)
+		assert.Equal(t, blocks.TotalCount, blocks.TotalPages)
 	}
 }
@@ -66,6 +66,70 @@ func ABCIQuery(path string, data cmn.HexBytes, height int64, prove bool) (*ctype
 	return &ctypes.ResultABCIQuery{*resQuery}, nil
 }
 
+// Get a Merkle proof of the value of a key in the application state, or of
+// its absence. The proof is made by the application, when queried with
+// `prove=true`, so this fails if it doesn't support proofs. It can be
+// verified with `merkle.ProofRuntime.VerifyValue` (or `VerifyAbsence`)
+// against the app hash in the header of the block at `height+1`.
+//
+// ```shell
+// curl 'localhost:26657/abci_proof?path="/key"&key="abcd"&height=0'
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// result, err := client.ABCIProof("/key", []byte("abcd"), 0)
+// ```
+//
+// > The above command returns JSON structured like this:
+//
+// ```json
+// {
+// 	"error": "",
+// 	"result": {
+// 		"key": "61626364",
+// 		"value": "61626364",
+// 		"height": "7",
+// 		"proof": {
+// 			"ops": [
+// 				{
+// 					"type": "simple:v",
+// 					"key": "YWJjZA==",
+// 					"data": "Cg0KC2..."
+// 				}
+// 			]
+// 		}
+// 	},
+// 	"id": "",
+// 	"jsonrpc": "2.0"
+// }
+// ```
+//
+// ### Query Parameters
+//
+// | Parameter | Type   | Default | Required | Description                                    |
+// |-----------+--------+---------+----------+------------------------------------------------|
+// | path      | string | false   | false    | Path to the data ("/a/b/c")                    |
+// | key       | []byte | false   | true     | Key                                            |
+// | height    | int64  | 0       | false    | Height (0 means latest)                        |
+func ABCIProof(path string, key cmn.HexBytes, height int64) (*ctypes.ResultABCIProof, error) {
+	resQuery, err := proxyAppQuery.QuerySync(abci.RequestQuery{
+		Path:   path,
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewResultABCIProof(*resQuery)
+}
+
 // Get some info about the application.
 //
 // ```shell
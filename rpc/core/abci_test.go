@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+// provingApp proves the values of its state, a simple map.
+type provingApp struct {
+	abci.BaseApplication
+	state map[string][]byte
+}
+
+func (app provingApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	value, ok := app.state[string(req.Data)]
+	if !ok {
+		return abci.ResponseQuery{Code: 1, Log: "missing"}
+	}
+	res := abci.ResponseQuery{Key: req.Data, Value: value, Height: 1}
+	if req.Prove {
+		_, proofs, _ := merkle.SimpleProofsFromMap(app.state)
+		op := merkle.NewSimpleValueOp(req.Data, proofs[string(req.Data)])
+		res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{op.ProofOp()}}
+	}
+	return res
+}
+
+func TestABCIProof(t *testing.T) {
+	app := provingApp{state: map[string][]byte{"a": []byte("1"), "b": []byte("2")}}
+	appConn := abcicli.NewLocalClient(nil, app)
+	SetProxyAppQuery(proxy.NewAppConnQuery(appConn))
+	appHash, _, _ := merkle.SimpleProofsFromMap(app.state)
+
+	res, err := ABCIProof("", []byte("b"), 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, "2", res.Value)
+	assert.EqualValues(t, 1, res.Height)
+
+	// the proof is verified by the usual proof runtime
+	keyPath := merkle.KeyPath{}.AppendKey(res.Key, merkle.KeyEncodingURL).String()
+	prt := merkle.DefaultProofRuntime()
+	assert.NoError(t, prt.VerifyValue(res.Proof, appHash, keyPath, res.Value))
+	assert.Error(t, prt.VerifyValue(res.Proof, appHash, keyPath, []byte("1")))
+
+	_, err = ABCIProof("", []byte("c"), 0)
+	assert.Error(t, err, "the query fails")
+
+	// an application that doesn't prove its state
+	appConn = abcicli.NewLocalClient(nil, abci.NewBaseApplication())
+	SetProxyAppQuery(proxy.NewAppConnQuery(appConn))
+	_, err = ABCIProof("", []byte("a"), 0)
+	assert.Error(t, err)
+}
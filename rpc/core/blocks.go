@@ -82,6 +82,10 @@ func BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, e
 	blockMetas := []*types.BlockMeta{}
 	for height := maxHeight; height >= minHeight; height-- {
 		blockMeta := blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			// pruned
+			continue
+		}
 		blockMetas = append(blockMetas, blockMeta)
 	}
 
@@ -231,9 +235,17 @@ func Block(heightPtr *int64) (*ctypes.ResultBlock, error) {
 		return nil, err
 	}
 
+	if res, ok := cache.get("block", height, storeHeight); ok {
+		return res.(*ctypes.ResultBlock), nil
+	}
+
 	blockMeta := blockStore.LoadBlockMeta(height)
 	block := blockStore.LoadBlock(height)
-	return &ctypes.ResultBlock{blockMeta, block}, nil
+	res := &ctypes.ResultBlock{blockMeta, block}
+	if block != nil {
+		cache.set("block", height, res)
+	}
+	return res, nil
 }
 
 // Get block commit at a given height.
@@ -318,7 +330,11 @@ func Commit(heightPtr *int64) (*ctypes.ResultCommit, error) {
 		return nil, err
 	}
 
-	header := blockStore.LoadBlockMeta(height).Header
+	blockMeta := blockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("Block %d has been pruned", height)
+	}
+	header := blockMeta.Header
 
 	// If the next block has not been committed yet,
 	// use a non-canonical commit
@@ -338,8 +354,14 @@ func Commit(heightPtr *int64) (*ctypes.ResultCommit, error) {
 // Results are for the height of the block containing the txs.
 // Thus response.results[5] is the results of executing getBlock(h).Txs[5]
 //
+// With `stream=true`, the response is sent in chunks, one JSON response per
+// line: the BeginBlock results, the results of every tx, and the EndBlock
+// results. `/tx_search` and `/block_search` support it too, with one tx or
+// block per line.
+//
 // ```shell
 // curl 'localhost:26657/block_results?height=10'
+// curl 'localhost:26657/block_results?height=10&stream=true'
 // ```
 //
 // ```go
@@ -350,6 +372,14 @@ func Commit(heightPtr *int64) (*ctypes.ResultCommit, error) {
 // }
 // defer client.Stop()
 // info, err := client.BlockResults(10)
+// //
+// results, err := client.BlockResultsStream(ctx, 10)
+// for part := range results {
+//   if part.Err != nil {
+//     // handle error
+//   }
+//   // handle part
+// }
 // ```
 //
 //
@@ -377,6 +407,10 @@ func BlockResults(heightPtr *int64) (*ctypes.ResultBlockResults, error) {
 		return nil, err
 	}
 
+	if res, ok := cache.get("block_results", height, storeHeight); ok {
+		return res.(*ctypes.ResultBlockResults), nil
+	}
+
 	// load the results
 	results, err := sm.LoadABCIResponses(stateDB, height)
 	if err != nil {
@@ -387,6 +421,7 @@ func BlockResults(heightPtr *int64) (*ctypes.ResultBlockResults, error) {
 		Height:  height,
 		Results: results,
 	}
+	cache.set("block_results", height, res)
 	return res, nil
 }
 
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// resultCache is an LRU cache of the results of the queries for committed
+// blocks, which never change, by endpoint and height. A nil *resultCache
+// caches nothing.
+type resultCache struct {
+	mtx  sync.Mutex
+	size int
+	list *list.List               // *resultCacheEntry, most recently used first
+	map_ map[string]*list.Element // by resultCacheKey
+
+	// the block store height when the cache was last used
+	storeHeight int64
+}
+
+type resultCacheEntry struct {
+	key    string
+	result interface{}
+}
+
+// newResultCache returns a cache holding up to size results, or nil if size
+// isn't positive.
+func newResultCache(size int) *resultCache {
+	if size <= 0 {
+		return nil
+	}
+	return &resultCache{
+		size: size,
+		list: list.New(),
+		map_: make(map[string]*list.Element, size),
+	}
+}
+
+func resultCacheKey(endpoint string, height int64) string {
+	return fmt.Sprintf("%s/%d", endpoint, height)
+}
+
+// get returns the cached result of endpoint for height. storeHeight is the
+// current height of the block store: if it went down, the chain was reset,
+// and the cache is cleared.
+func (c *resultCache) get(endpoint string, height, storeHeight int64) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if storeHeight < c.storeHeight {
+		c.clear()
+	}
+	c.storeHeight = storeHeight
+
+	e, ok := c.map_[resultCacheKey(endpoint, height)]
+	if !ok {
+		return nil, false
+	}
+	c.list.MoveToFront(e)
+	return e.Value.(*resultCacheEntry).result, true
+}
+
+// set caches the result of endpoint for height, evicting the least recently
+// used result if the cache is full.
+func (c *resultCache) set(endpoint string, height int64, result interface{}) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := resultCacheKey(endpoint, height)
+	if e, ok := c.map_[key]; ok {
+		e.Value.(*resultCacheEntry).result = result
+		c.list.MoveToFront(e)
+		return
+	}
+	if c.list.Len() >= c.size {
+		oldest := c.list.Back()
+		delete(c.map_, oldest.Value.(*resultCacheEntry).key)
+		c.list.Remove(oldest)
+	}
+	c.map_[key] = c.list.PushFront(&resultCacheEntry{key, result})
+}
+
+// reset clears the cache.
+func (c *resultCache) reset() {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.clear()
+	c.storeHeight = 0
+}
+
+// CONTRACT: c.mtx is held.
+func (c *resultCache) clear() {
+	c.list.Init()
+	c.map_ = make(map[string]*list.Element, c.size)
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCache(t *testing.T) {
+	c := newResultCache(2)
+
+	c.set("block", 1, "b1")
+	c.set("block_results", 1, "r1")
+	res, ok := c.get("block", 1, 10)
+	assert.True(t, ok)
+	assert.Equal(t, "b1", res)
+
+	// block_results/1 is the least recently used
+	c.set("block", 2, "b2")
+	_, ok = c.get("block_results", 1, 10)
+	assert.False(t, ok)
+	_, ok = c.get("block", 1, 10)
+	assert.True(t, ok)
+	_, ok = c.get("block", 2, 10)
+	assert.True(t, ok)
+
+	// the chain was reset
+	_, ok = c.get("block", 1, 5)
+	assert.False(t, ok)
+
+	c.set("block", 1, "b1")
+	c.reset()
+	_, ok = c.get("block", 1, 10)
+	assert.False(t, ok)
+
+	// disabled
+	c = newResultCache(0)
+	c.set("block", 1, "b1")
+	_, ok = c.get("block", 1, 10)
+	assert.False(t, ok)
+}
@@ -4,6 +4,8 @@ import (
 	"os"
 	"runtime/pprof"
 
+	"github.com/pkg/errors"
+
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -49,3 +51,36 @@ func UnsafeWriteHeapProfile(filename string) (*ctypes.ResultUnsafeProfile, error
 
 	return &ctypes.ResultUnsafeProfile{}, nil
 }
+
+// UnsafeBackup makes a consistent copy of the block store in the given
+// directory without stopping the node (see BlockStore.Backup). As it writes
+// to the disk of the node, it needs `jwt_secret` to be set, and `backup` not
+// to be one of the `public_endpoints`.
+//
+// ```shell
+// curl -H "Authorization: Bearer $TOKEN" 'localhost:26657/backup?dir="/backups/2019-01-01"'
+// ```
+func UnsafeBackup(dir string) (*ctypes.ResultBackup, error) {
+	if config.JWTSecret == "" {
+		return nil, errors.New("Backups need jwt_secret to be set")
+	}
+	for _, endpoint := range config.PublicEndpoints {
+		if endpoint == "backup" {
+			return nil, errors.New("Backups can't be a public endpoint")
+		}
+	}
+	if dir == "" {
+		return nil, errors.New("No directory provided")
+	}
+	backuper, ok := blockStore.(interface {
+		Backup(destDir string) error
+	})
+	if !ok {
+		return nil, errors.New("The block store doesn't support backups")
+	}
+	logger.Info("Backup", "dir", dir)
+	if err := backuper.Backup(dir); err != nil {
+		return nil, errors.Wrap(err, "Error backing up the block store")
+	}
+	return &ctypes.ResultBackup{Dir: dir}, nil
+}
@@ -3,15 +3,24 @@ package core
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 
+	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// the subscriptions made with SubscribeEvents
+var (
+	subscriptionsMtx    sync.Mutex
+	clientSubscriptions = make(map[string]int) // by client
+	lastSubscriptionID  int
+)
+
 // Subscribe for events via WebSocket.
 //
 // To tell which events you want, you need to provide a query. query is a
@@ -27,8 +36,10 @@ import (
 //		tm.event = 'Tx' AND tx.hash = 'XYZ' # single transaction
 //		tm.event = 'Tx' AND tx.height = 5		# all txs of the fifth block
 //		tx.height = 5												# all txs of the fifth block
+//		tm.event = 'NewBlock' AND block.height >= 100 AND block.height <= 200
 //
-// Tendermint provides a few predefined keys: tm.event, tx.hash and tx.height.
+// Tendermint provides a few predefined keys: tm.event, tx.hash, tx.height and
+// block.height (for NewBlock and NewBlockHeader events).
 // Note for transactions, you can define additional keys by providing tags with
 // DeliverTx response.
 //
@@ -94,15 +105,11 @@ func Subscribe(wsCtx rpctypes.WSRPCContext, query string) (*ctypes.ResultSubscri
 	addr := wsCtx.GetRemoteAddr()
 	logger.Info("Subscribe to query", "remote", addr, "query", query)
 
-	q, err := tmquery.New(query)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse query")
+	if max := config.MaxSubscriptionsPerClient; max > 0 && eventBus.NumClientSubscriptions(addr) >= max {
+		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", max)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
-	defer cancel()
-	ch := make(chan interface{})
-	err = eventBusFor(wsCtx).Subscribe(ctx, addr, q, ch)
+	_, ch, err := subscribe(eventBusFor(wsCtx), addr, query)
 	if err != nil {
 		return nil, err
 	}
@@ -202,3 +209,67 @@ func eventBusFor(wsCtx rpctypes.WSRPCContext) tmtypes.EventBusSubscriber {
 	}
 	return es
 }
+
+// SubscribeEvents subscribes client to the events matching query, for the
+// transports other than WebSocket (e.g. gRPC). The events are sent to the
+// returned channel until ctx is done, and the channel is then closed. The
+// caller must keep reading from the channel until it is closed.
+//
+// Every call is a separate subscription, even for the same query, so that it
+// ends with ctx only.
+func SubscribeEvents(ctx context.Context, client, query string) (<-chan interface{}, error) {
+	logger.Info("Subscribe to query", "remote", client, "query", query)
+
+	subscriptionsMtx.Lock()
+	if max := config.MaxSubscriptionsPerClient; max > 0 && clientSubscriptions[client] >= max {
+		subscriptionsMtx.Unlock()
+		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", max)
+	}
+	clientSubscriptions[client]++
+	lastSubscriptionID++
+	subscriber := fmt.Sprintf("%s#%d", client, lastSubscriptionID)
+	subscriptionsMtx.Unlock()
+
+	q, ch, err := subscribe(eventBus, subscriber, query)
+	if err != nil {
+		releaseSubscription(client)
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		// closes ch
+		err := eventBus.Unsubscribe(context.Background(), subscriber, q)
+		if err != nil {
+			logger.Error("Failed to unsubscribe from query", "remote", client, "query", query, "err", err)
+		}
+		releaseSubscription(client)
+	}()
+
+	return ch, nil
+}
+
+func releaseSubscription(client string) {
+	subscriptionsMtx.Lock()
+	defer subscriptionsMtx.Unlock()
+	clientSubscriptions[client]--
+	if clientSubscriptions[client] == 0 {
+		delete(clientSubscriptions, client)
+	}
+}
+
+func subscribe(es tmtypes.EventBusSubscriber, subscriber, query string) (tmpubsub.Query, chan interface{}, error) {
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse query")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
+	defer cancel()
+	ch := make(chan interface{})
+	err = es.Subscribe(ctx, subscriber, q, ch)
+	if err != nil {
+		return nil, nil, err
+	}
+	return q, ch, nil
+}
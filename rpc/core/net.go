@@ -2,6 +2,8 @@ package core
 
 import (
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -92,6 +94,81 @@ func UnsafeDialPeers(peers []string, persistent bool) (*ctypes.ResultDialPeers,
 	return &ctypes.ResultDialPeers{"Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeBlacklistPeer disconnects the peer with the given ID, or the peers
+// with the given IP, and refuses connections to and from them for the given
+// duration (e.g. "1h30m"). An empty duration bans them until they are
+// unblacklisted.
+//
+// ```shell
+// curl 'localhost:26657/blacklist_peer?id="429fcf25974313b95673f58d77eacdd434402665"&duration="24h"'
+// curl 'localhost:26657/blacklist_peer?ip="1.2.3.4"'
+// ```
+func UnsafeBlacklistPeer(id, ip, duration string) (*ctypes.ResultBlacklistPeer, error) {
+	peerID, peerIP, err := blacklistTarget(id, ip)
+	if err != nil {
+		return &ctypes.ResultBlacklistPeer{}, err
+	}
+	var d time.Duration
+	if duration != "" {
+		d, err = time.ParseDuration(duration)
+		if err != nil {
+			return &ctypes.ResultBlacklistPeer{}, errors.Wrap(err, "invalid duration")
+		}
+		if d <= 0 {
+			return &ctypes.ResultBlacklistPeer{}, errors.New("duration must be positive")
+		}
+	}
+	logger.Info("BlacklistPeer", "id", id, "ip", ip, "duration", d)
+	if peerIP != nil {
+		p2pPeers.BlacklistIP(peerIP, d)
+		return &ctypes.ResultBlacklistPeer{Log: fmt.Sprintf("Blacklisted IP %s", peerIP)}, nil
+	}
+	p2pPeers.Blacklist(peerID, d)
+	return &ctypes.ResultBlacklistPeer{Log: fmt.Sprintf("Blacklisted peer %s", peerID)}, nil
+}
+
+// UnsafeUnblacklistPeer lifts the ban on the peer with the given ID, or the
+// peers with the given IP.
+//
+// ```shell
+// curl 'localhost:26657/unblacklist_peer?id="429fcf25974313b95673f58d77eacdd434402665"'
+// ```
+func UnsafeUnblacklistPeer(id, ip string) (*ctypes.ResultUnblacklistPeer, error) {
+	peerID, peerIP, err := blacklistTarget(id, ip)
+	if err != nil {
+		return &ctypes.ResultUnblacklistPeer{}, err
+	}
+	logger.Info("UnblacklistPeer", "id", id, "ip", ip)
+	if peerIP != nil {
+		p2pPeers.UnblacklistIP(peerIP)
+		return &ctypes.ResultUnblacklistPeer{Log: fmt.Sprintf("Unblacklisted IP %s", peerIP)}, nil
+	}
+	p2pPeers.Unblacklist(peerID)
+	return &ctypes.ResultUnblacklistPeer{Log: fmt.Sprintf("Unblacklisted peer %s", peerID)}, nil
+}
+
+// blacklistTarget parses the peer ID or the IP, exactly one of which must be
+// given.
+func blacklistTarget(id, ip string) (p2p.ID, net.IP, error) {
+	switch {
+	case id == "" && ip == "":
+		return "", nil, errors.New("No peer ID or IP provided")
+	case id != "" && ip != "":
+		return "", nil, errors.New("Only one of a peer ID or an IP can be provided")
+	case ip != "":
+		peerIP := net.ParseIP(ip)
+		if peerIP == nil {
+			return "", nil, fmt.Errorf("invalid IP %q", ip)
+		}
+		return "", peerIP, nil
+	default:
+		if err := p2p.ID(id).Validate(); err != nil {
+			return "", nil, errors.Wrap(err, "invalid peer ID")
+		}
+		return p2p.ID(id), nil, nil
+	}
+}
+
 // Get genesis file.
 //
 // ```shell
@@ -1,6 +1,10 @@
 package core
 
 import (
+	"net"
+	"time"
+
+	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -43,6 +47,10 @@ type peers interface {
 	DialPeersAsync(p2p.AddrBook, []string, bool) error
 	NumPeers() (outbound, inbound, dialig int)
 	Peers() p2p.IPeerSet
+	Blacklist(p2p.ID, time.Duration)
+	Unblacklist(p2p.ID)
+	BlacklistIP(net.IP, time.Duration)
+	UnblacklistIP(net.IP)
 }
 
 //----------------------------------------------
@@ -71,6 +79,9 @@ var (
 	mempool          *mempl.Mempool
 
 	logger log.Logger
+
+	config cfg.RPCConfig
+	cache  *resultCache
 )
 
 func SetStateDB(db dbm.DB) {
@@ -107,6 +118,8 @@ func SetPubKey(pk crypto.PubKey) {
 
 func SetGenesisDoc(doc *types.GenesisDoc) {
 	genDoc = doc
+	// the cached results are for another chain
+	cache.reset()
 }
 
 func SetAddrBook(book p2p.AddrBook) {
@@ -133,6 +146,12 @@ func SetEventBus(b *types.EventBus) {
 	eventBus = b
 }
 
+// SetConfig sets an RPCConfig.
+func SetConfig(c cfg.RPCConfig) {
+	config = c
+	cache = newResultCache(c.ResultCacheSize)
+}
+
 func validatePage(page, perPage, totalCount int) int {
 	if perPage < 1 {
 		return 1
@@ -148,6 +167,12 @@ func validatePage(page, perPage, totalCount int) int {
 	return page
 }
 
+// totalPages returns the number of pages of perPage entries needed for
+// totalCount entries.
+func totalPages(totalCount, perPage int) int {
+	return (totalCount + perPage - 1) / perPage
+}
+
 func validatePerPage(perPage int) int {
 	if perPage < 1 || perPage > maxPerPage {
 		return defaultPerPage
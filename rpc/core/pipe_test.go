@@ -67,3 +67,23 @@ func TestPaginationPerPage(t *testing.T) {
 		assert.Equal(t, c.newPerPage, p, fmt.Sprintf("%v", c))
 	}
 }
+
+func TestPaginationTotalPages(t *testing.T) {
+
+	cases := []struct {
+		totalCount int
+		perPage    int
+		totalPages int
+	}{
+		{0, 10, 0},
+		{1, 10, 1},
+		{10, 10, 1},
+		{11, 10, 2},
+		{30, 1, 30},
+	}
+
+	for _, c := range cases {
+		p := totalPages(c.totalCount, c.perPage)
+		assert.Equal(t, c.totalPages, p, fmt.Sprintf("%v", c))
+	}
+}
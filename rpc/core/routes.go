@@ -23,6 +23,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"commit":               rpc.NewRPCFunc(Commit, "height"),
 	"tx":                   rpc.NewRPCFunc(Tx, "hash,prove"),
 	"tx_search":            rpc.NewRPCFunc(TxSearch, "query,prove,page,per_page"),
+	"block_search":         rpc.NewRPCFunc(BlockSearch, "query,page,per_page"),
 	"validators":           rpc.NewRPCFunc(Validators, "height"),
 	"dump_consensus_state": rpc.NewRPCFunc(DumpConsensusState, ""),
 	"consensus_state":      rpc.NewRPCFunc(ConsensusState, ""),
@@ -37,6 +38,7 @@ var Routes = map[string]*rpc.RPCFunc{
 
 	// abci API
 	"abci_query": rpc.NewRPCFunc(ABCIQuery, "path,data,height,prove"),
+	"abci_proof": rpc.NewRPCFunc(ABCIProof, "path,key,height"),
 	"abci_info":  rpc.NewRPCFunc(ABCIInfo, ""),
 }
 
@@ -44,7 +46,10 @@ func AddUnsafeRoutes() {
 	// control API
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent")
+	Routes["blacklist_peer"] = rpc.NewRPCFunc(UnsafeBlacklistPeer, "id,ip,duration")
+	Routes["unblacklist_peer"] = rpc.NewRPCFunc(UnsafeUnblacklistPeer, "id,ip")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	Routes["backup"] = rpc.NewRPCFunc(UnsafeBackup, "dir")
 
 	// profiler API
 	Routes["unsafe_start_cpu_profiler"] = rpc.NewRPCFunc(UnsafeStartCPUProfiler, "filename")
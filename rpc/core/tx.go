@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"sort"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 
@@ -98,6 +99,9 @@ func Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	var proof types.TxProof
 	if prove {
 		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return nil, fmt.Errorf("Block %d has been pruned", height)
+		}
 		proof = block.Data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
 	}
 
@@ -160,7 +164,8 @@ func Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 //         "hash": "2B8EC32BA2579B3B8606E42C06DE2F7AFA2556EF"
 //       }
 //     ],
-//     "total_count": "1"
+//     "total_count": "1",
+//     "total_pages": "1"
 //   }
 // }
 // ```
@@ -212,6 +217,9 @@ func TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSear
 
 		if prove {
 			block := blockStore.LoadBlock(height)
+			if block == nil {
+				return nil, fmt.Errorf("Block %d has been pruned", height)
+			}
 			proof = block.Data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
 		}
 
@@ -225,5 +233,106 @@ func TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSear
 		}
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	return &ctypes.ResultTxSearch{
+		Txs:        apiResults,
+		TotalCount: totalCount,
+		TotalPages: totalPages(totalCount, perPage),
+	}, nil
+}
+
+// BlockSearch allows you to query for the blocks including transactions
+// matching a query. It returns a list of blocks (maximum ?per_page entries),
+// by increasing height, and the total count.
+//
+// ```shell
+// curl "localhost:26657/block_search?query=\"account.owner='Ivan'\"&page=1&per_page=30"
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// result, err := client.BlockSearch("account.owner='Ivan'", 1, 30)
+// ```
+//
+// > The above command returns JSON structured like this:
+//
+// ```json
+// {
+//   "jsonrpc": "2.0",
+//   "id": "",
+//   "result": {
+//     "blocks": [
+//       {
+//         "block_meta": {...},
+//         "block": {...}
+//       }
+//     ],
+//     "total_count": "1",
+//     "total_pages": "1"
+//   }
+// }
+// ```
+//
+// ### Query Parameters
+//
+// | Parameter | Type   | Default | Required | Description                             |
+// |-----------+--------+---------+----------+-----------------------------------------|
+// | query     | string | ""      | true     | Query on the transactions of the blocks |
+// | page      | int    | 1       | false    | Page number (1-based)                   |
+// | per_page  | int    | 30      | false    | Number of entries per page (max: 100)   |
+//
+// ### Returns
+//
+// - `blocks`: the blocks, as returned by `/block`
+// - `total_count`: `int` - number of blocks matching the query
+// - `total_pages`: `int` - number of pages of per_page blocks
+func BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	// if index is disabled, return error
+	if _, ok := txIndexer.(*null.TxIndex); ok {
+		return nil, fmt.Errorf("Transaction indexing is disabled")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := txIndexer.Search(q)
+	if err != nil {
+		return nil, err
+	}
+
+	heights := make([]int64, 0, len(results))
+	seen := make(map[int64]bool, len(results))
+	for _, r := range results {
+		if !seen[r.Height] {
+			seen[r.Height] = true
+			heights = append(heights, r.Height)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	totalCount := len(heights)
+	perPage = validatePerPage(perPage)
+	page = validatePage(page, perPage, totalCount)
+	skipCount := (page - 1) * perPage
+
+	apiResults := make([]*ctypes.ResultBlock, cmn.MinInt(perPage, totalCount-skipCount))
+	for i := 0; i < len(apiResults); i++ {
+		height := heights[skipCount+i]
+		apiResults[i] = &ctypes.ResultBlock{
+			BlockMeta: blockStore.LoadBlockMeta(height),
+			Block:     blockStore.LoadBlock(height),
+		}
+	}
+
+	return &ctypes.ResultBlockSearch{
+		Blocks:     apiResults,
+		TotalCount: totalCount,
+		TotalPages: totalPages(totalCount, perPage),
+	}, nil
 }
@@ -2,10 +2,13 @@ package core_types
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/tendermint/tendermint/p2p"
@@ -42,6 +45,33 @@ type ResultBlockResults struct {
 	Results *state.ABCIResponses `json:"results"`
 }
 
+// StreamParts splits the results into the BeginBlock response, one part per
+// DeliverTx response and the EndBlock response, in that order.
+func (r *ResultBlockResults) StreamParts(send func(part interface{}) bool) {
+	if r.Results == nil {
+		send(r)
+		return
+	}
+	if !send(&ResultBlockResults{
+		Height:  r.Height,
+		Results: &state.ABCIResponses{BeginBlock: r.Results.BeginBlock},
+	}) {
+		return
+	}
+	for _, deliverTx := range r.Results.DeliverTx {
+		if !send(&ResultBlockResults{
+			Height:  r.Height,
+			Results: &state.ABCIResponses{DeliverTx: []*abci.ResponseDeliverTx{deliverTx}},
+		}) {
+			return
+		}
+	}
+	send(&ResultBlockResults{
+		Height:  r.Height,
+		Results: &state.ABCIResponses{EndBlock: r.Results.EndBlock},
+	})
+}
+
 // NewResultCommit is a helper to initialize the ResultCommit with
 // the embedded struct
 func NewResultCommit(header *types.Header, commit *types.Commit,
@@ -105,6 +135,21 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Log from blacklisting a peer
+type ResultBlacklistPeer struct {
+	Log string `json:"log"`
+}
+
+// Log from unblacklisting a peer
+type ResultUnblacklistPeer struct {
+	Log string `json:"log"`
+}
+
+// Directory of a backup
+type ResultBackup struct {
+	Dir string `json:"dir"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
@@ -173,6 +218,42 @@ type ResultTx struct {
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
 	TotalCount int         `json:"total_count"`
+	TotalPages int         `json:"total_pages"`
+}
+
+// StreamParts splits the result into one part per tx, or returns it as is if
+// there are none.
+func (r *ResultTxSearch) StreamParts(send func(part interface{}) bool) {
+	if len(r.Txs) == 0 {
+		send(r)
+		return
+	}
+	for _, tx := range r.Txs {
+		if !send(&ResultTxSearch{Txs: []*ResultTx{tx}, TotalCount: r.TotalCount, TotalPages: r.TotalPages}) {
+			return
+		}
+	}
+}
+
+// Result of searching for blocks
+type ResultBlockSearch struct {
+	Blocks     []*ResultBlock `json:"blocks"`
+	TotalCount int            `json:"total_count"`
+	TotalPages int            `json:"total_pages"`
+}
+
+// StreamParts splits the result into one part per block, or returns it as
+// is if there are none.
+func (r *ResultBlockSearch) StreamParts(send func(part interface{}) bool) {
+	if len(r.Blocks) == 0 {
+		send(r)
+		return
+	}
+	for _, block := range r.Blocks {
+		if !send(&ResultBlockSearch{Blocks: []*ResultBlock{block}, TotalCount: r.TotalCount, TotalPages: r.TotalPages}) {
+			return
+		}
+	}
 }
 
 // List of mempool txs
@@ -191,6 +272,32 @@ type ResultABCIQuery struct {
 	Response abci.ResponseQuery `json:"response"`
 }
 
+// Proof of a value of the application state
+type ResultABCIProof struct {
+	Key    cmn.HexBytes  `json:"key"`
+	Value  cmn.HexBytes  `json:"value"`
+	Height int64         `json:"height"`
+	Proof  *merkle.Proof `json:"proof"`
+}
+
+// NewResultABCIProof returns the proof of the value in the response to a
+// query with Prove set, or an error if the query failed or the application
+// didn't prove the value.
+func NewResultABCIProof(res abci.ResponseQuery) (*ResultABCIProof, error) {
+	if res.IsErr() {
+		return nil, fmt.Errorf("Query failed with code %d: %s", res.Code, res.Log)
+	}
+	if res.Proof == nil {
+		return nil, errors.New("The application returned no proof")
+	}
+	return &ResultABCIProof{
+		Key:    res.Key,
+		Value:  res.Value,
+		Height: res.Height,
+		Proof:  res.Proof,
+	}, nil
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -3,8 +3,13 @@ package core_grpc
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/peer"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	core "github.com/tendermint/tendermint/rpc/core"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
 )
 
 type broadcastAPI struct {
@@ -34,3 +39,156 @@ func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcast
 		},
 	}, nil
 }
+
+//----------------------------------------
+
+// the events of a subscription buffered for slow clients, before they are
+// dropped
+const subscriptionBufferSize = 100
+
+type coreAPI struct {
+}
+
+func (capi *coreAPI) Status(ctx context.Context, req *RequestEmpty) (*ResponseResult, error) {
+	return newResponseResult(core.Status())
+}
+
+func (capi *coreAPI) ABCIInfo(ctx context.Context, req *RequestEmpty) (*ResponseResult, error) {
+	return newResponseResult(core.ABCIInfo())
+}
+
+func (capi *coreAPI) ABCIQuery(ctx context.Context, req *RequestABCIQuery) (*ResponseResult, error) {
+	return newResponseResult(core.ABCIQuery(req.Path, req.Data, req.Height, req.Prove))
+}
+
+func (capi *coreAPI) ABCIProof(ctx context.Context, req *RequestABCIProof) (*ResponseResult, error) {
+	return newResponseResult(core.ABCIProof(req.Path, req.Key, req.Height))
+}
+
+func (capi *coreAPI) BroadcastTxCommit(ctx context.Context, req *RequestBroadcastTx) (*ResponseResult, error) {
+	return newResponseResult(core.BroadcastTxCommit(req.Tx))
+}
+
+func (capi *coreAPI) BroadcastTxAsync(ctx context.Context, req *RequestBroadcastTx) (*ResponseResult, error) {
+	return newResponseResult(core.BroadcastTxAsync(req.Tx))
+}
+
+func (capi *coreAPI) BroadcastTxSync(ctx context.Context, req *RequestBroadcastTx) (*ResponseResult, error) {
+	return newResponseResult(core.BroadcastTxSync(req.Tx))
+}
+
+func (capi *coreAPI) Block(ctx context.Context, req *RequestHeight) (*ResponseResult, error) {
+	return newResponseResult(core.Block(heightPtr(req.Height)))
+}
+
+func (capi *coreAPI) BlockResults(ctx context.Context, req *RequestHeight) (*ResponseResult, error) {
+	return newResponseResult(core.BlockResults(heightPtr(req.Height)))
+}
+
+func (capi *coreAPI) Commit(ctx context.Context, req *RequestHeight) (*ResponseResult, error) {
+	return newResponseResult(core.Commit(heightPtr(req.Height)))
+}
+
+func (capi *coreAPI) Validators(ctx context.Context, req *RequestHeight) (*ResponseResult, error) {
+	return newResponseResult(core.Validators(heightPtr(req.Height)))
+}
+
+func (capi *coreAPI) Tx(ctx context.Context, req *RequestTx) (*ResponseResult, error) {
+	return newResponseResult(core.Tx(req.Hash, req.Prove))
+}
+
+func (capi *coreAPI) TxSearch(ctx context.Context, req *RequestTxSearch) (*ResponseResult, error) {
+	return newResponseResult(core.TxSearch(req.Query, req.Prove, int(req.Page), int(req.PerPage)))
+}
+
+func (capi *coreAPI) BlockSearch(ctx context.Context, req *RequestBlockSearch) (*ResponseResult, error) {
+	return newResponseResult(core.BlockSearch(req.Query, int(req.Page), int(req.PerPage)))
+}
+
+func (capi *coreAPI) Genesis(ctx context.Context, req *RequestEmpty) (*ResponseResult, error) {
+	return newResponseResult(core.Genesis())
+}
+
+func (capi *coreAPI) BlockchainInfo(ctx context.Context, req *RequestBlockchainInfo) (*ResponseResult, error) {
+	return newResponseResult(core.BlockchainInfo(req.MinHeight, req.MaxHeight))
+}
+
+func (capi *coreAPI) NetInfo(ctx context.Context, req *RequestEmpty) (*ResponseResult, error) {
+	return newResponseResult(core.NetInfo())
+}
+
+func (capi *coreAPI) DumpConsensusState(ctx context.Context, req *RequestEmpty) (*ResponseResult, error) {
+	return newResponseResult(core.DumpConsensusState())
+}
+
+func (capi *coreAPI) ConsensusState(ctx context.Context, req *RequestEmpty) (*ResponseResult, error) {
+	return newResponseResult(core.ConsensusState())
+}
+
+func (capi *coreAPI) Health(ctx context.Context, req *RequestEmpty) (*ResponseResult, error) {
+	return newResponseResult(core.Health())
+}
+
+// Subscribe streams the events matching req.Query until the client cancels
+// the call. Like WebSocket clients, the client is identified by its remote
+// address for max_subscriptions_per_client, and events are dropped if it
+// doesn't keep up.
+func (capi *coreAPI) Subscribe(req *RequestSubscribe, stream CoreAPI_SubscribeServer) error {
+	ctx := stream.Context()
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return errors.New("unknown peer")
+	}
+	addr := p.Addr.String()
+
+	ch, err := core.SubscribeEvents(ctx, addr, req.Query)
+	if err != nil {
+		return err
+	}
+
+	// don't block the event bus on a slow client
+	events := make(chan interface{}, subscriptionBufferSize)
+	go func() {
+		defer close(events)
+		for event := range ch {
+			select {
+			case events <- event:
+			default:
+			}
+		}
+	}()
+
+	// confirm the subscription
+	err = stream.Send(&ResponseResult{})
+	// keep reading events until the subscription ends with ctx
+	for event := range events {
+		if err != nil {
+			continue
+		}
+		var res *ResponseResult
+		res, err = newResponseResult(&ctypes.ResultEvent{Query: req.Query, Data: event.(types.TMEventData)}, nil)
+		if err == nil {
+			err = stream.Send(res)
+		}
+	}
+	return err
+}
+
+func newResponseResult(result interface{}, err error) (*ResponseResult, error) {
+	if err != nil {
+		return nil, err
+	}
+	bz, err := cdc.MarshalJSON(result)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseResult{Result: bz}, nil
+}
+
+// heightPtr returns nil, the latest height for the core functions, for 0.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
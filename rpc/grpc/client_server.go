@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
 )
 
 // Config is an gRPC server configuration.
@@ -14,11 +15,21 @@ type Config struct {
 	MaxOpenConnections int
 }
 
-// StartGRPCServer starts a new gRPC BroadcastAPIServer using the given net.Listener.
+// StartGRPCServer starts a new gRPC server, serving BroadcastAPI and CoreAPI,
+// using the given net.Listener. The calls are rate limited and authenticated
+// according to config, as those of the HTTP RPC server.
 // NOTE: This function blocks - you may want to call it in a go-routine.
-func StartGRPCServer(ln net.Listener) error {
-	grpcServer := grpc.NewServer()
+func StartGRPCServer(ln net.Listener, config rpcserver.Config) error {
+	g, err := newGuard(config)
+	if err != nil {
+		return err
+	}
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(g.unaryInterceptor),
+		grpc.StreamInterceptor(g.streamInterceptor),
+	)
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
+	RegisterCoreAPIServer(grpcServer, &coreAPI{})
 	return grpcServer.Serve(ln)
 }
 
@@ -32,6 +43,16 @@ func StartGRPCClient(protoAddr string) BroadcastAPIClient {
 	return NewBroadcastAPIClient(conn)
 }
 
+// StartGRPCCoreClient dials the gRPC server using protoAddr and returns a new
+// CoreAPIClient, and the connection to close when done.
+func StartGRPCCoreClient(protoAddr string) (CoreAPIClient, *grpc.ClientConn) {
+	conn, err := grpc.Dial(protoAddr, grpc.WithInsecure(), grpc.WithDialer(dialerFunc))
+	if err != nil {
+		panic(err)
+	}
+	return NewCoreAPIClient(conn), conn
+}
+
 func dialerFunc(addr string, timeout time.Duration) (net.Conn, error) {
 	return cmn.Connect(addr)
 }
@@ -0,0 +1,75 @@
+package core_grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+)
+
+// guard applies the rate limit and authentication of the HTTP RPC server (see
+// rpcserver.RateLimitHandler and rpcserver.AuthHandler) to gRPC calls.
+//
+// The token is read from the "authorization" metadata, as "Bearer <token>".
+// Unlike over HTTP, all the calls need one if a secret is set.
+type guard struct {
+	limiter *rpcserver.IPRateLimiter // nil - unlimited
+	secret  []byte                   // empty - no authentication
+}
+
+func newGuard(config rpcserver.Config) (*guard, error) {
+	limiter, err := rpcserver.NewIPRateLimiter(config)
+	if err != nil {
+		return nil, err
+	}
+	return &guard{limiter: limiter, secret: []byte(config.JWTSecret)}, nil
+}
+
+// check returns a gRPC status error if the call of ctx is rate limited or
+// isn't authenticated.
+func (g *guard) check(ctx context.Context) error {
+	if g.limiter != nil {
+		if p, ok := peer.FromContext(ctx); ok {
+			if addr, ok := p.Addr.(*net.TCPAddr); ok {
+				if delay := g.limiter.Reserve(addr.IP, time.Now()); delay > 0 {
+					return status.Errorf(codes.ResourceExhausted, "Too many requests, retry after %v", delay)
+				}
+			}
+		}
+	}
+
+	if len(g.secret) == 0 {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	auth := md.Get("authorization")
+	if len(auth) == 0 || !strings.HasPrefix(auth[0], "Bearer ") {
+		return status.Error(codes.Unauthenticated, "Missing bearer token")
+	}
+	if _, err := rpcserver.VerifyJWT(g.secret, strings.TrimPrefix(auth[0], "Bearer "), time.Now()); err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return nil
+}
+
+func (g *guard) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := g.check(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (g *guard) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := g.check(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
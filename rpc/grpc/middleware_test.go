@@ -0,0 +1,54 @@
+package core_grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+)
+
+func TestGuard(t *testing.T) {
+	secret := "a-secret-of-at-least-32-characters!!"
+	g, err := newGuard(rpcserver.Config{
+		MaxRequestsPerSecond: 1,
+		MaxRequestsBurst:     2,
+		JWTSecret:            secret,
+	})
+	require.NoError(t, err)
+
+	token, err := rpcserver.GenerateJWT([]byte(secret), rpcserver.JWTClaims{Subject: "test"})
+	require.NoError(t, err)
+	call := func(ip string, auth string) codes.Code {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1000},
+		})
+		if auth != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", auth))
+		}
+		return status.Code(g.check(ctx))
+	}
+
+	// authentication
+	assert.Equal(t, codes.Unauthenticated, call("1.2.3.4", ""))
+	assert.Equal(t, codes.Unauthenticated, call("1.2.3.5", "Bearer "+token+"x"))
+	assert.Equal(t, codes.OK, call("1.2.3.6", "Bearer "+token))
+
+	// burst, then limited
+	assert.Equal(t, codes.OK, call("1.2.3.6", "Bearer "+token))
+	assert.Equal(t, codes.ResourceExhausted, call("1.2.3.6", "Bearer "+token))
+
+	// disabled
+	g, err = newGuard(rpcserver.Config{})
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, g.check(context.Background()))
+	}
+}
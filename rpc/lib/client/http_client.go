@@ -2,8 +2,10 @@ package rpcclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -124,6 +126,34 @@ func (c *JSONRPCClient) Call(method string, params map[string]interface{}, resul
 	return unmarshalResponseBytes(c.cdc, responseBytes, result)
 }
 
+// CallStream calls method with the stream=true query parameter, and returns
+// a ResponseStream reading the parts of the result. The stream must be closed
+// by the caller.
+func (c *JSONRPCClient) CallStream(ctx context.Context, method string, params map[string]interface{}) (*ResponseStream, error) {
+	request, err := types.MapToRequest(c.cdc, types.JSONRPCStringID("jsonrpc-client"), method, params)
+	if err != nil {
+		return nil, err
+	}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest, err := http.NewRequest("POST", c.address+"?stream=true", bytes.NewBuffer(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "text/json")
+	httpResponse, err := c.client.Do(httpRequest.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseStream{
+		body: httpResponse.Body,
+		dec:  json.NewDecoder(httpResponse.Body),
+		cdc:  c.cdc,
+	}, nil
+}
+
 func (c *JSONRPCClient) Codec() *amino.Codec {
 	return c.cdc
 }
@@ -179,6 +209,40 @@ func (c *URIClient) SetCodec(cdc *amino.Codec) {
 
 //------------------------------------------------
 
+// ResponseStream reads the parts of a result sent in chunks, one JSON
+// RPCResponse after the other.
+type ResponseStream struct {
+	body io.ReadCloser
+	dec  *json.Decoder
+	cdc  *amino.Codec
+}
+
+// Next reads the next part of the result into result. It returns io.EOF
+// after the last part.
+func (s *ResponseStream) Next(result interface{}) error {
+	response := &types.RPCResponse{}
+	if err := s.dec.Decode(response); err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return errors.Errorf("Error unmarshalling rpc response: %v", err)
+	}
+	if response.Error != nil {
+		return errors.Errorf("Response error: %v", response.Error)
+	}
+	if err := s.cdc.UnmarshalJSON(response.Result, result); err != nil {
+		return errors.Errorf("Error unmarshalling rpc response result: %v", err)
+	}
+	return nil
+}
+
+// Close closes the underlying connection.
+func (s *ResponseStream) Close() error {
+	return s.body.Close()
+}
+
+//------------------------------------------------
+
 func unmarshalResponseBytes(cdc *amino.Codec, responseBytes []byte, result interface{}) (interface{}, error) {
 	// Read response.  If rpc/core/types is imported, the result will unmarshal
 	// into the correct type.
@@ -0,0 +1,169 @@
+package rpcserver
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/libs/log"
+	types "github.com/tendermint/tendermint/rpc/lib/types"
+)
+
+// JWTClaims are the claims of the tokens accepted by AuthHandler.
+type JWTClaims struct {
+	Subject   string `json:"sub,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"` // 0 - never expires
+}
+
+// only HMAC-SHA256 signed tokens are issued and accepted
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// GenerateJWT returns a token with the given claims, signed with secret.
+func GenerateJWT(secret []byte, claims JWTClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(jwtSignature(secret, signed)), nil
+}
+
+// VerifyJWT checks that token is signed with secret and not expired at now,
+// and returns its claims.
+func VerifyJWT(secret []byte, token string, now time.Time) (JWTClaims, error) {
+	var claims JWTClaims
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims, errors.New("Malformed token")
+	}
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return claims, errors.Wrap(err, "Malformed token header")
+	}
+	var h struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(header, &h); err != nil {
+		return claims, errors.Wrap(err, "Malformed token header")
+	}
+	if h.Alg != "HS256" {
+		return claims, errors.Errorf("Unsupported token algorithm %q", h.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return claims, errors.Wrap(err, "Malformed token signature")
+	}
+	if !hmac.Equal(sig, jwtSignature(secret, parts[0]+"."+parts[1])) {
+		return claims, errors.New("Invalid token signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims, errors.Wrap(err, "Malformed token payload")
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, errors.Wrap(err, "Malformed token payload")
+	}
+	if claims.ExpiresAt != 0 && now.Unix() >= claims.ExpiresAt {
+		return claims, errors.New("Token has expired")
+	}
+	return claims, nil
+}
+
+func jwtSignature(secret []byte, signed string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed)) // nolint: errcheck, gas
+	return mac.Sum(nil)
+}
+
+//-----------------------------------------------------------------------------
+
+// AuthHandler wraps handler, requiring the requests to include an
+// "Authorization: Bearer <token>" header, with a token signed with
+// config.JWTSecret (see GenerateJWT). Requests without a valid token receive
+// HTTP 401.
+//
+// Requests for one of config.PublicEndpoints (e.g. "status") don't need a
+// token, whether they are sent as URI or JSONRPC requests. WebSocket
+// connections always need one.
+//
+// If config.JWTSecret is empty, handler is returned as is.
+func AuthHandler(handler http.Handler, config Config, logger log.Logger) http.Handler {
+	if config.JWTSecret == "" {
+		return handler
+	}
+	public := make(map[string]bool, len(config.PublicEndpoints))
+	for _, endpoint := range config.PublicEndpoints {
+		public[endpoint] = true
+	}
+	return &authHandler{
+		h:      handler,
+		secret: []byte(config.JWTSecret),
+		public: public,
+		logger: logger,
+	}
+}
+
+type authHandler struct {
+	h      http.Handler
+	secret []byte
+	public map[string]bool
+	logger log.Logger
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.public[h.requestMethod(r)] {
+		h.h.ServeHTTP(w, r)
+		return
+	}
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		h.unauthorized(w, r, errors.New("Missing bearer token"))
+		return
+	}
+	if _, err := VerifyJWT(h.secret, strings.TrimPrefix(auth, "Bearer "), time.Now()); err != nil {
+		h.unauthorized(w, r, err)
+		return
+	}
+	h.h.ServeHTTP(w, r)
+}
+
+// requestMethod returns the RPC method called by r, or "" if there is none,
+// leaving r.Body readable.
+func (h *authHandler) requestMethod(r *http.Request) string {
+	if r.URL.Path != "/" {
+		return strings.TrimPrefix(r.URL.Path, "/")
+	}
+	if r.Body == nil {
+		return ""
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return ""
+	}
+	var request types.RPCRequest
+	if err := json.Unmarshal(b, &request); err != nil {
+		return ""
+	}
+	return request.Method
+}
+
+func (h *authHandler) unauthorized(w http.ResponseWriter, r *http.Request, err error) {
+	h.logger.Debug("Unauthorized RPC request", "remoteAddr", r.RemoteAddr, "url", r.URL, "err", err)
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	WriteRPCResponseHTTPError(w, http.StatusUnauthorized,
+		types.RPCServerError(types.JSONRPCStringID(""), errors.Wrap(err, "Unauthorized")))
+}
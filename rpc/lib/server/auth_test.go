@@ -0,0 +1,77 @@
+package rpcserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestJWT(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+
+	token, err := GenerateJWT(secret, JWTClaims{Subject: "ops", ExpiresAt: now.Add(time.Minute).Unix()})
+	require.NoError(t, err)
+	claims, err := VerifyJWT(secret, token, now)
+	require.NoError(t, err)
+	assert.Equal(t, "ops", claims.Subject)
+
+	_, err = VerifyJWT(secret, token, now.Add(time.Hour))
+	assert.Error(t, err, "expired")
+	_, err = VerifyJWT([]byte("other secret"), token, now)
+	assert.Error(t, err, "wrong secret")
+
+	// unsigned tokens are rejected
+	parts := strings.Split(token, ".")
+	_, err = VerifyJWT(secret, "eyJhbGciOiJub25lIn0."+parts[1]+".", now)
+	assert.Error(t, err, "alg none")
+
+	for _, malformed := range []string{"", "a.b", "a.b.c", token + "x"} {
+		_, err = VerifyJWT(secret, malformed, now)
+		assert.Error(t, err, malformed)
+	}
+}
+
+func TestAuthHandler(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	h := AuthHandler(ok, Config{
+		JWTSecret:       "secret",
+		PublicEndpoints: []string{"status"},
+	}, log.TestingLogger())
+
+	token, err := GenerateJWT([]byte("secret"), JWTClaims{})
+	require.NoError(t, err)
+
+	cases := []struct {
+		method, path, body, token string
+		code                      int
+	}{
+		{"GET", "/block", "", "", http.StatusUnauthorized},
+		{"GET", "/block", "", "bad", http.StatusUnauthorized},
+		{"GET", "/block", "", token, http.StatusOK},
+		{"GET", "/status", "", "", http.StatusOK},
+		{"POST", "/", `{"jsonrpc":"2.0","id":"0","method":"block"}`, "", http.StatusUnauthorized},
+		{"POST", "/", `{"jsonrpc":"2.0","id":"0","method":"block"}`, token, http.StatusOK},
+		{"POST", "/", `{"jsonrpc":"2.0","id":"0","method":"status"}`, "", http.StatusOK},
+		{"GET", "/websocket", "", "", http.StatusUnauthorized},
+	}
+	for i, c := range cases {
+		req := httptest.NewRequest(c.method, c.path, strings.NewReader(c.body))
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, c.code, rec.Code, "#%d", i)
+	}
+
+	// disabled without a secret
+	assert.IsType(t, ok, AuthHandler(ok, Config{}, log.TestingLogger()))
+}
@@ -0,0 +1,103 @@
+package rpcserver
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// the certificate files are checked for changes at most this often
+const certCheckInterval = time.Second
+
+// CertReloader holds a TLS certificate loaded from a pair of files, and
+// reloads it when one of them is modified, so that a renewed certificate
+// (e.g. by Let's Encrypt or Vault) is used without restarting the server.
+// Use its GetCertificate method as tls.Config.GetCertificate.
+type CertReloader struct {
+	certFile      string
+	keyFile       string
+	checkInterval time.Duration
+	logger        log.Logger
+
+	mtx         sync.Mutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+	lastChecked time.Time
+}
+
+// NewCertReloader loads the certificate from certFile and keyFile.
+func NewCertReloader(certFile, keyFile string, logger log.Logger) (*CertReloader, error) {
+	r := &CertReloader{
+		certFile:      certFile,
+		keyFile:       keyFile,
+		checkInterval: certCheckInterval,
+		logger:        logger,
+	}
+	certModTime, keyModTime, err := r.modTimes()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(certModTime, keyModTime); err != nil {
+		return nil, err
+	}
+	r.lastChecked = time.Now()
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, reloading it first if its
+// files were modified since it was loaded. If the new files can't be loaded
+// (e.g. while they are being written), the previous certificate is returned,
+// and loading them is retried on the next check.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	now := time.Now()
+	if now.Sub(r.lastChecked) < r.checkInterval {
+		return r.cert, nil
+	}
+	r.lastChecked = now
+
+	certModTime, keyModTime, err := r.modTimes()
+	if err != nil {
+		r.logger.Error("Failed to check TLS certificate", "err", err)
+		return r.cert, nil
+	}
+	if certModTime.Equal(r.certModTime) && keyModTime.Equal(r.keyModTime) {
+		return r.cert, nil
+	}
+	if err := r.load(certModTime, keyModTime); err != nil {
+		r.logger.Error("Failed to reload TLS certificate", "err", err)
+		return r.cert, nil
+	}
+	r.logger.Info("Reloaded TLS certificate", "cert", r.certFile, "key", r.keyFile)
+	return r.cert, nil
+}
+
+func (r *CertReloader) modTimes() (certModTime, keyModTime time.Time, err error) {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return
+	}
+	return certInfo.ModTime(), keyInfo.ModTime(), nil
+}
+
+// CONTRACT: r.mtx is held, or r isn't shared yet.
+func (r *CertReloader) load(certModTime, keyModTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.cert = &cert
+	r.certModTime = certModTime
+	r.keyModTime = keyModTime
+	return nil
+}
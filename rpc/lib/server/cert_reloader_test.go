@@ -0,0 +1,84 @@
+package rpcserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// writeCert writes a new self-signed certificate with the given serial
+// number, and its key, with modification time modTime.
+func writeCert(t *testing.T, certFile, keyFile string, serial int64, modTime time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	require.NoError(t, err)
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func certSerial(t *testing.T, r *CertReloader) int64 {
+	cert, err := r.GetCertificate(nil)
+	require.NoError(t, err)
+	x509Cert, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return x509Cert.SerialNumber.Int64()
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cert_reloader_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	now := time.Now()
+	writeCert(t, certFile, keyFile, 1, now)
+	r, err := NewCertReloader(certFile, keyFile, log.TestingLogger())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, certSerial(t, r))
+
+	// not checked again before checkInterval
+	writeCert(t, certFile, keyFile, 2, now.Add(time.Second))
+	assert.EqualValues(t, 1, certSerial(t, r))
+
+	r.checkInterval = 0
+	assert.EqualValues(t, 2, certSerial(t, r))
+
+	// the previous certificate is kept until the new files can be loaded
+	err = ioutil.WriteFile(keyFile, []byte("partial"), 0600)
+	require.NoError(t, err)
+	require.NoError(t, os.Chtimes(keyFile, now.Add(2*time.Second), now.Add(2*time.Second)))
+	assert.EqualValues(t, 2, certSerial(t, r))
+	writeCert(t, certFile, keyFile, 3, now.Add(3*time.Second))
+	assert.EqualValues(t, 3, certSerial(t, r))
+
+	_, err = NewCertReloader(filepath.Join(dir, "missing.pem"), keyFile, log.TestingLogger())
+	assert.Error(t, err)
+}
@@ -149,6 +149,12 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, cdc *amino.Codec, logger lo
 			WriteRPCResponseHTTP(w, types.RPCInternalError(request.ID, err))
 			return
 		}
+		if streamable, ok := streamableResult(r, result); ok {
+			WriteRPCResponseHTTPStream(w, streamable, func(part interface{}) types.RPCResponse {
+				return types.NewRPCSuccessResponse(cdc, request.ID, part)
+			})
+			return
+		}
 		WriteRPCResponseHTTP(w, types.NewRPCSuccessResponse(cdc, request.ID, result))
 	}
 }
@@ -273,10 +279,27 @@ func makeHTTPHandler(rpcFunc *RPCFunc, cdc *amino.Codec, logger log.Logger) func
 			WriteRPCResponseHTTP(w, types.RPCInternalError(types.JSONRPCStringID(""), err))
 			return
 		}
+		if streamable, ok := streamableResult(r, result); ok {
+			WriteRPCResponseHTTPStream(w, streamable, func(part interface{}) types.RPCResponse {
+				return types.NewRPCSuccessResponse(cdc, types.JSONRPCStringID(""), part)
+			})
+			return
+		}
 		WriteRPCResponseHTTP(w, types.NewRPCSuccessResponse(cdc, types.JSONRPCStringID(""), result))
 	}
 }
 
+// streamableResult returns result as a StreamableResult if it's one and the
+// request has the stream=true query parameter.
+func streamableResult(r *http.Request, result interface{}) (types.StreamableResult, bool) {
+	// see unreflectResult
+	streamable, ok := reflect.ValueOf(result).Elem().Interface().(types.StreamableResult)
+	if !ok || r.URL.Query().Get("stream") != "true" {
+		return nil, false
+	}
+	return streamable, true
+}
+
 // Covert an http query to a list of properly typed values.
 // To be properly decoded the arg must be a concrete type from tendermint (if its an interface).
 func httpParamsToArgs(rpcFunc *RPCFunc, cdc *amino.Codec, r *http.Request) ([]reflect.Value, error) {
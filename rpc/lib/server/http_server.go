@@ -3,6 +3,7 @@ package rpcserver
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -21,6 +22,18 @@ import (
 // Config is an RPC server configuration.
 type Config struct {
 	MaxOpenConnections int
+
+	// See RateLimitHandler.
+	MaxRequestsPerSecond int
+	MaxRequestsBurst     int
+	RateLimitWhitelist   []string
+
+	// See AuthHandler.
+	JWTSecret       string
+	PublicEndpoints []string
+
+	// See VersionedHandler.
+	DeprecateLegacyPaths bool
 }
 
 const (
@@ -58,6 +71,7 @@ func StartHTTPServer(listener net.Listener, handler http.Handler, logger log.Log
 
 // StartHTTPAndTLSServer takes a listener and starts an HTTPS server with the given handler.
 // It wraps handler with RecoverAndLogHandler.
+// The certificate is reloaded when certFile or keyFile change (see CertReloader).
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func StartHTTPAndTLSServer(
 	listener net.Listener,
@@ -67,13 +81,19 @@ func StartHTTPAndTLSServer(
 ) error {
 	logger.Info(fmt.Sprintf("Starting RPC HTTPS server on %s (cert: %q, key: %q)",
 		listener.Addr(), certFile, keyFile))
+	certReloader, err := NewCertReloader(certFile, keyFile, logger)
+	if err != nil {
+		logger.Error("RPC HTTPS server stopped", "err", err)
+		return err
+	}
 	s := &http.Server{
 		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: maxBodyBytes}, logger),
 		ReadTimeout:    ReadTimeout,
 		WriteTimeout:   WriteTimeout,
 		MaxHeaderBytes: maxHeaderBytes,
+		TLSConfig:      &tls.Config{GetCertificate: certReloader.GetCertificate},
 	}
-	err := s.ServeTLS(listener, certFile, keyFile)
+	err = s.ServeTLS(listener, "", "")
 
 	logger.Error("RPC HTTPS server stopped", "err", err)
 	return err
@@ -104,6 +124,30 @@ func WriteRPCResponseHTTP(w http.ResponseWriter, res types.RPCResponse) {
 	w.Write(jsonBytes) // nolint: errcheck, gas
 }
 
+// WriteRPCResponseHTTPStream writes the parts of result as newline delimited
+// JSON, each in the RPCResponse returned by newResponse. Every part is marshalled
+// and flushed as it's produced, so that they are sent in chunks. An error
+// response ends the stream.
+func WriteRPCResponseHTTPStream(w http.ResponseWriter, result types.StreamableResult, newResponse func(part interface{}) types.RPCResponse) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(200)
+	flusher, _ := w.(http.Flusher)
+	result.StreamParts(func(part interface{}) bool {
+		res := newResponse(part)
+		jsonBytes, err := json.Marshal(res)
+		if err != nil {
+			panic(err)
+		}
+		if _, err := w.Write(append(jsonBytes, '\n')); err != nil {
+			return false
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return res.Error == nil
+	})
+}
+
 //-----------------------------------------------------------------------------
 
 // Wraps an HTTP handler, adding error logging.
@@ -163,6 +207,13 @@ func (w *ResponseWriterWrapper) WriteHeader(status int) {
 	w.ResponseWriter.WriteHeader(status)
 }
 
+// implements http.Flusher
+func (w *ResponseWriterWrapper) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 // implements http.Hijacker
 func (w *ResponseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return w.ResponseWriter.(http.Hijacker).Hijack()
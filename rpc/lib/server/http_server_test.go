@@ -1,19 +1,26 @@
 package rpcserver
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/log"
+	types "github.com/tendermint/tendermint/rpc/lib/types"
 )
 
 func TestMaxOpenConnections(t *testing.T) {
@@ -77,3 +84,42 @@ func TestStartHTTPAndTLSServer(t *testing.T) {
 
 	// TODO: test that starting the server can actually work
 }
+
+// countParts streams the numbers from 1 to n.
+type countParts int
+
+func (n countParts) StreamParts(send func(part interface{}) bool) {
+	for i := 1; i <= int(n); i++ {
+		if !send(i) {
+			return
+		}
+	}
+}
+
+func TestWriteRPCResponseHTTPStream(t *testing.T) {
+	cdc := amino.NewCodec()
+	id := types.JSONRPCStringID("stream")
+
+	w := httptest.NewRecorder()
+	WriteRPCResponseHTTPStream(w, countParts(3), func(part interface{}) types.RPCResponse {
+		return types.NewRPCSuccessResponse(cdc, id, part)
+	})
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Equal(t, 3, strings.Count(w.Body.String(), "\n"))
+	assert.True(t, w.Flushed)
+
+	// an error response ends the stream
+	w = httptest.NewRecorder()
+	WriteRPCResponseHTTPStream(w, countParts(3), func(part interface{}) types.RPCResponse {
+		if part.(int) == 2 {
+			return types.RPCInternalError(id, errors.New("failed"))
+		}
+		return types.NewRPCSuccessResponse(cdc, id, part)
+	})
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	require.Equal(t, 2, len(lines))
+	res := types.RPCResponse{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &res))
+	require.NotNil(t, res.Error)
+	assert.Equal(t, "failed", res.Error.Data)
+}
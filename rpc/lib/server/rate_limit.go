@@ -0,0 +1,189 @@
+package rpcserver
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/tendermint/tendermint/libs/log"
+	types "github.com/tendermint/tendermint/rpc/lib/types"
+)
+
+// idle limiters are dropped at most this often
+const rateLimitCleanupInterval = time.Minute
+
+// RateLimitHandler wraps handler, limiting the requests of every client IP to
+// config.MaxRequestsPerSecond, with bursts of up to config.MaxRequestsBurst
+// requests (defaults to MaxRequestsPerSecond). Requests above the limit are
+// answered with HTTP 429 and a Retry-After header.
+//
+// Clients whose IP matches one of config.RateLimitWhitelist (IPs or CIDR
+// blocks) are not limited, nor are clients connected over a unix socket.
+// The IP is the remote address of the connection: clients behind a proxy
+// share the limit of the proxy.
+//
+// If config.MaxRequestsPerSecond is 0, handler is returned as is.
+func RateLimitHandler(handler http.Handler, config Config, logger log.Logger) (http.Handler, error) {
+	limiter, err := NewIPRateLimiter(config)
+	if err != nil || limiter == nil {
+		return handler, err
+	}
+	return &rateLimitHandler{
+		h:       handler,
+		limiter: limiter,
+		logger:  logger,
+	}, nil
+}
+
+// ParseIPNets parses a list of IPs and CIDR blocks.
+func ParseIPNets(addrs []string) ([]*net.IPNet, error) {
+	ipNets := make([]*net.IPNet, 0, len(addrs))
+	for _, addr := range addrs {
+		if strings.Contains(addr, "/") {
+			_, ipNet, err := net.ParseCIDR(addr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Invalid CIDR block %q", addr)
+			}
+			ipNets = append(ipNets, ipNet)
+			continue
+		}
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			return nil, errors.Errorf("Invalid IP %q", addr)
+		}
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 8*net.IPv4len
+		}
+		ipNets = append(ipNets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return ipNets, nil
+}
+
+type rateLimitHandler struct {
+	h       http.Handler
+	limiter *IPRateLimiter
+	logger  log.Logger
+}
+
+func (h *rateLimitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	ip := net.ParseIP(host)
+	if err != nil || ip == nil {
+		h.h.ServeHTTP(w, r)
+		return
+	}
+
+	if delay := h.limiter.Reserve(ip, time.Now()); delay > 0 {
+		h.logger.Debug("Rate limited RPC request", "remoteAddr", r.RemoteAddr, "retryAfter", delay)
+		retryAfter := int(math.Ceil(delay.Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		WriteRPCResponseHTTPError(w, http.StatusTooManyRequests,
+			types.RPCServerError(types.JSONRPCStringID(""), errors.New("Too many requests")))
+		return
+	}
+	h.h.ServeHTTP(w, r)
+}
+
+//-----------------------------------------------------------------------------
+
+type ipLimiter struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+// IPRateLimiter limits the requests of every client IP as described in
+// RateLimitHandler.
+type IPRateLimiter struct {
+	limit     rate.Limit
+	burst     int
+	whitelist []*net.IPNet
+
+	mtx         sync.Mutex
+	limiters    map[string]*ipLimiter // by client IP
+	lastCleanup time.Time
+}
+
+// NewIPRateLimiter returns a limiter for config, or nil if
+// config.MaxRequestsPerSecond is 0.
+func NewIPRateLimiter(config Config) (*IPRateLimiter, error) {
+	if config.MaxRequestsPerSecond <= 0 {
+		return nil, nil
+	}
+	whitelist, err := ParseIPNets(config.RateLimitWhitelist)
+	if err != nil {
+		return nil, err
+	}
+	burst := config.MaxRequestsBurst
+	if burst <= 0 {
+		burst = config.MaxRequestsPerSecond
+	}
+	return &IPRateLimiter{
+		limit:     rate.Limit(config.MaxRequestsPerSecond),
+		burst:     burst,
+		whitelist: whitelist,
+		limiters:  make(map[string]*ipLimiter),
+	}, nil
+}
+
+// Reserve takes a token from the bucket of ip, and returns 0 on success, or
+// how long to wait until a token is available. Whitelisted IPs always succeed.
+func (l *IPRateLimiter) Reserve(ip net.IP, now time.Time) time.Duration {
+	if l.whitelisted(ip) {
+		return 0
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.cleanup(now)
+
+	key := ip.String()
+	il, ok := l.limiters[key]
+	if !ok {
+		il = &ipLimiter{Limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[key] = il
+	}
+	il.lastSeen = now
+
+	res := il.ReserveN(now, 1)
+	delay := res.DelayFrom(now)
+	if delay > 0 {
+		// don't count rejected requests
+		res.CancelAt(now)
+	}
+	return delay
+}
+
+func (l *IPRateLimiter) whitelisted(ip net.IP) bool {
+	for _, ipNet := range l.whitelist {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanup drops the limiters whose bucket has refilled, which behave like new
+// ones.
+// CONTRACT: l.mtx is held.
+func (l *IPRateLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < rateLimitCleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+
+	refill := time.Duration(float64(l.burst) / float64(l.limit) * float64(time.Second))
+	for ip, il := range l.limiters {
+		if now.Sub(il.lastSeen) > refill {
+			delete(l.limiters, ip)
+		}
+	}
+}
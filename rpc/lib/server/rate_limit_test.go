@@ -0,0 +1,60 @@
+package rpcserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestRateLimitHandler(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	h, err := RateLimitHandler(ok, Config{
+		MaxRequestsPerSecond: 1,
+		MaxRequestsBurst:     2,
+		RateLimitWhitelist:   []string{"10.0.0.1", "192.168.0.0/16"},
+	}, log.TestingLogger())
+	require.NoError(t, err)
+
+	get := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/status", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// burst, then limited
+	assert.Equal(t, http.StatusOK, get("1.2.3.4:1000").Code)
+	assert.Equal(t, http.StatusOK, get("1.2.3.4:1001").Code)
+	rec := get("1.2.3.4:1002")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	// other IPs have their own limit
+	assert.Equal(t, http.StatusOK, get("[::1]:1000").Code)
+
+	// whitelisted IPs and unix sockets are not limited
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, get("10.0.0.1:1000").Code)
+		assert.Equal(t, http.StatusOK, get("192.168.1.1:1000").Code)
+		assert.Equal(t, http.StatusOK, get("@").Code)
+	}
+}
+
+func TestRateLimitHandlerDisabled(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	h, err := RateLimitHandler(ok, Config{}, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, ok, h)
+
+	_, err = RateLimitHandler(ok, Config{
+		MaxRequestsPerSecond: 1,
+		RateLimitWhitelist:   []string{"localhost"},
+	}, log.TestingLogger())
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package rpcserver
+
+import (
+	"net/http"
+)
+
+// APIVersionPrefix is the path prefix of the current version of the RPC
+// endpoints, e.g. /v1/status.
+const APIVersionPrefix = "/v1"
+
+// VersionedHandler serves handler under APIVersionPrefix, and at the root for
+// the clients using the unversioned paths. Those are deprecated, and will be
+// removed in the next major version: if config.DeprecateLegacyPaths is set,
+// their responses include a Deprecation header, and a Link header to the
+// versioned path.
+func VersionedHandler(handler http.Handler, config Config) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(APIVersionPrefix+"/", http.StripPrefix(APIVersionPrefix, handler))
+	if config.DeprecateLegacyPaths {
+		mux.Handle("/", deprecatedPathHandler{handler})
+	} else {
+		mux.Handle("/", handler)
+	}
+	return mux
+}
+
+type deprecatedPathHandler struct {
+	h http.Handler
+}
+
+func (h deprecatedPathHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Deprecation", "true")
+	w.Header().Set("Link", "<"+APIVersionPrefix+r.URL.Path+`>; rel="successor-version"`)
+	h.h.ServeHTTP(w, r)
+}
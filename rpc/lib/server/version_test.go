@@ -0,0 +1,43 @@
+package rpcserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionedHandler(t *testing.T) {
+	var path string
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+	})
+
+	get := func(h http.Handler, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", target, nil))
+		return rec
+	}
+
+	vh := VersionedHandler(h, Config{})
+	for target, wantPath := range map[string]string{
+		"/v1/status":    "/status",
+		"/v1/":          "/",
+		"/v1/websocket": "/websocket",
+		"/status":       "/status",
+		"/":             "/",
+	} {
+		rec := get(vh, target)
+		assert.Equal(t, http.StatusOK, rec.Code, target)
+		assert.Equal(t, wantPath, path, target)
+		assert.Empty(t, rec.Header().Get("Deprecation"), target)
+	}
+
+	vh = VersionedHandler(h, Config{DeprecateLegacyPaths: true})
+	rec := get(vh, "/status")
+	assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+	assert.Equal(t, `</v1/status>; rel="successor-version"`, rec.Header().Get("Link"))
+	rec = get(vh, "/v1/status")
+	assert.Empty(t, rec.Header().Get("Deprecation"))
+}
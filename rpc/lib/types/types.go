@@ -235,6 +235,18 @@ func RPCServerError(id jsonrpcid, err error) RPCResponse {
 
 //----------------------------------------
 
+// StreamableResult is implemented by the results that can be sent in parts,
+// one JSON RPCResponse per line, when an HTTP request has the stream=true
+// query parameter.
+type StreamableResult interface {
+	// StreamParts splits the result into results of the same type, and calls
+	// send with each of them as it's produced. It stops early if send returns
+	// false.
+	StreamParts(send func(part interface{}) bool)
+}
+
+//----------------------------------------
+
 // *wsConnection implements this interface.
 type WSRPCConnection interface {
 	GetRemoteAddr() string
@@ -12,6 +12,10 @@ const MetricsSubsystem = "state"
 type Metrics struct {
 	// Time between BeginBlock and EndBlock.
 	BlockProcessingTime metrics.Histogram
+	// Number of blocks kept in the block store.
+	StoreSize metrics.Gauge
+	// Number of blocks waiting to be pruned.
+	PruningLag metrics.Gauge
 }
 
 func PrometheusMetrics(namespace string) *Metrics {
@@ -23,11 +27,25 @@ func PrometheusMetrics(namespace string) *Metrics {
 			Help:      "Time between BeginBlock and EndBlock in ms.",
 			Buckets:   stdprometheus.LinearBuckets(1, 10, 10),
 		}, []string{}),
+		StoreSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "store_size",
+			Help:      "Number of blocks kept in the block store.",
+		}, []string{}),
+		PruningLag: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruning_lag",
+			Help:      "Number of blocks waiting to be pruned.",
+		}, []string{}),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
 		BlockProcessingTime: discard.NewHistogram(),
+		StoreSize:           discard.NewGauge(),
+		PruningLag:          discard.NewGauge(),
 	}
 }
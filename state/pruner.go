@@ -0,0 +1,182 @@
+package state
+
+import (
+	"context"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	prunerSubscriber = "Pruner"
+
+	// the latest blocks always kept: consensus needs the previous block (e.g.
+	// for its metrics and to know if it needs a proof block)
+	minKeepRecent = 2
+)
+
+var prunedHeightKey = []byte("prunedHeightKey")
+
+// Pruner deletes the blocks, and their ABCI responses, that its retention
+// policy doesn't keep: it keeps the latest keepRecent blocks, and every block
+// whose height is a multiple of keepEvery (0 - none). It prunes
+// asynchronously after each committed block, so that committing isn't
+// delayed by it. The pruned height is saved in the state DB, so that the
+// Pruner resumes from it after a restart.
+//
+// The validator sets and consensus params aren't pruned, as the ones of a
+// height refer to the height they last changed at.
+type Pruner struct {
+	cmn.BaseService
+
+	keepRecent int64
+	keepEvery  int64
+
+	db         dbm.DB
+	blockStore PrunableBlockStore
+	eventBus   *types.EventBus
+
+	// signalled (without blocking) when a new block was committed
+	blockCh chan struct{}
+
+	// all the heights up to pruned were pruned. Only accessed by pruneRoutine
+	// once started.
+	pruned int64
+
+	metrics *Metrics
+}
+
+// PrunerOption sets an optional parameter on the Pruner.
+type PrunerOption func(*Pruner)
+
+// PrunerWithMetrics sets the metrics.
+func PrunerWithMetrics(metrics *Metrics) PrunerOption {
+	return func(p *Pruner) { p.metrics = metrics }
+}
+
+// NewPruner returns a new Pruner keeping the latest keepRecent blocks, which
+// must be positive, and every keepEvery-th block. The latest 2 blocks are
+// kept in any case.
+func NewPruner(keepRecent, keepEvery int64, db dbm.DB, blockStore PrunableBlockStore,
+	eventBus *types.EventBus, options ...PrunerOption) *Pruner {
+	if keepRecent < 1 {
+		cmn.PanicSanity("Pruner must keep at least the latest block")
+	}
+	if keepRecent < minKeepRecent {
+		keepRecent = minKeepRecent
+	}
+	p := &Pruner{
+		keepRecent: keepRecent,
+		keepEvery:  keepEvery,
+		db:         db,
+		blockStore: blockStore,
+		eventBus:   eventBus,
+		blockCh:    make(chan struct{}, 1),
+		metrics:    NopMetrics(),
+	}
+	p.BaseService = *cmn.NewBaseService(nil, "Pruner", p)
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// OnStart implements cmn.Service by subscribing to new blocks and starting
+// to prune the blocks committed since the last pruned height.
+func (p *Pruner) OnStart() error {
+	p.pruned = loadPrunedHeight(p.db)
+
+	headersCh := make(chan interface{})
+	if err := p.eventBus.Subscribe(context.Background(), prunerSubscriber, types.EventQueryNewBlockHeader, headersCh); err != nil {
+		return err
+	}
+	go func() {
+		for range headersCh {
+			select {
+			case p.blockCh <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	p.blockCh <- struct{}{}
+	go p.pruneRoutine()
+	return nil
+}
+
+// OnStop implements cmn.Service by unsubscribing from new blocks.
+func (p *Pruner) OnStop() {
+	if p.eventBus.IsRunning() {
+		_ = p.eventBus.UnsubscribeAll(context.Background(), prunerSubscriber)
+	}
+}
+
+func (p *Pruner) pruneRoutine() {
+	for {
+		select {
+		case <-p.blockCh:
+			p.prune()
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// prune deletes the blocks not kept since the last pruned height.
+func (p *Pruner) prune() {
+	pruned := p.pruned
+	defer func() {
+		if p.pruned != pruned {
+			savePrunedHeight(p.db, p.pruned)
+		}
+	}()
+
+	latest := p.blockStore.Height()
+	for height := p.pruned + 1; height <= latest-p.keepRecent; height++ {
+		select {
+		case <-p.Quit():
+			return
+		default:
+		}
+
+		if !p.keep(height) {
+			if err := p.blockStore.DeleteBlock(height); err != nil {
+				p.Logger.Error("Failed to prune block", "height", height, "err", err)
+				return
+			}
+			DeleteABCIResponses(p.db, height)
+		}
+		p.pruned = height
+		p.metrics.PruningLag.Set(float64(latest - p.keepRecent - height))
+	}
+	p.metrics.PruningLag.Set(0)
+	p.metrics.StoreSize.Set(float64(latest - p.prunedBlocks()))
+	p.Logger.Debug("Pruned blocks", "height", p.pruned)
+}
+
+// keep returns true if the policy keeps the block at height once it's no
+// longer one of the latest blocks.
+func (p *Pruner) keep(height int64) bool {
+	return p.keepEvery > 0 && height%p.keepEvery == 0
+}
+
+// prunedBlocks returns the number of blocks deleted up to the pruned height.
+func (p *Pruner) prunedBlocks() int64 {
+	if p.keepEvery == 0 {
+		return p.pruned
+	}
+	return p.pruned - p.pruned/p.keepEvery
+}
+
+func loadPrunedHeight(db dbm.DB) int64 {
+	var height int64
+	if bz := db.Get(prunedHeightKey); len(bz) > 0 {
+		cdc.MustUnmarshalBinaryBare(bz, &height)
+	}
+	return height
+}
+
+func savePrunedHeight(db dbm.DB, height int64) {
+	db.Set(prunedHeightKey, cdc.MustMarshalBinaryBare(height))
+}
@@ -0,0 +1,113 @@
+package state
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+// prunableBlockStore only keeps track of the heights of its blocks.
+type prunableBlockStore struct {
+	BlockStore
+
+	mtx     sync.Mutex
+	height  int64
+	deleted map[int64]bool
+}
+
+func (bs *prunableBlockStore) Height() int64 {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	return bs.height
+}
+
+func (bs *prunableBlockStore) DeleteBlock(height int64) error {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	bs.deleted[height] = true
+	return nil
+}
+
+func (bs *prunableBlockStore) isDeleted(height int64) bool {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	return bs.deleted[height]
+}
+
+func (bs *prunableBlockStore) commit(t *testing.T, eventBus *types.EventBus, db dbm.DB) {
+	bs.mtx.Lock()
+	bs.height++
+	height := bs.height
+	bs.mtx.Unlock()
+	saveABCIResponses(db, height, &ABCIResponses{EndBlock: &abci.ResponseEndBlock{}})
+	err := eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{Header: types.Header{Height: height}})
+	require.NoError(t, err)
+}
+
+func TestPruner(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop()
+
+	db := dbm.NewMemDB()
+	bs := &prunableBlockStore{deleted: make(map[int64]bool)}
+	for i := 0; i < 5; i++ {
+		bs.commit(t, eventBus, db)
+	}
+
+	// keep the latest 3 blocks and every 4th block
+	pruner := NewPruner(3, 4, db, bs, eventBus)
+	pruner.SetLogger(log.TestingLogger())
+	require.NoError(t, pruner.Start())
+	defer pruner.Stop()
+
+	// the blocks committed before starting are pruned too
+	for i := 0; i < 5; i++ {
+		bs.commit(t, eventBus, db)
+	}
+	for start := time.Now(); !bs.isDeleted(7); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("timed out waiting for block 7 to be pruned")
+		}
+	}
+
+	for h := int64(1); h <= bs.Height(); h++ {
+		kept := h%4 == 0 || h > bs.Height()-3
+		assert.Equal(t, !kept, bs.isDeleted(h), "height %d", h)
+		_, err := LoadABCIResponses(db, h)
+		assert.Equal(t, kept, err == nil, "height %d", h)
+	}
+
+	// the pruned height is saved
+	for start := time.Now(); loadPrunedHeight(db) != 7; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("timed out waiting for the pruned height to be saved")
+		}
+	}
+	require.NoError(t, pruner.Stop())
+
+	// a new pruner resumes from it, and always keeps the previous block
+	bs.mtx.Lock()
+	bs.deleted = make(map[int64]bool)
+	bs.mtx.Unlock()
+	pruner = NewPruner(1, 0, db, bs, eventBus)
+	pruner.SetLogger(log.TestingLogger())
+	require.NoError(t, pruner.Start())
+	defer pruner.Stop()
+	for start := time.Now(); !bs.isDeleted(8); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("timed out waiting for block 8 to be pruned")
+		}
+	}
+	for h := int64(1); h <= bs.Height(); h++ {
+		assert.Equal(t, h == 8, bs.isDeleted(h), "height %d", h)
+	}
+}
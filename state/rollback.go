@@ -0,0 +1,104 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/types"
+)
+
+// RollbackState resets the state in db to the state after the block at
+// height, which must be lower than the latest height, and returns it. The
+// records of the later heights are deleted. The state is rebuilt from the
+// validators and consensus params in db, and the headers of the blocks at
+// height and height+1, which must be in blockStore: the blocks must be
+// deleted from it only afterwards.
+//
+// The application state isn't rolled back: the application must be reset
+// to height (or lower, for the handshake to replay the blocks up to height)
+// separately.
+func RollbackState(db dbm.DB, blockStore BlockStoreRPC, height int64) (State, error) {
+	state := LoadState(db)
+	if height < 1 || height >= state.LastBlockHeight {
+		return state, fmt.Errorf("Can only roll back to a height in [1, %d), not %d", state.LastBlockHeight, height)
+	}
+	blockMeta := blockStore.LoadBlockMeta(height)
+	nextBlockMeta := blockStore.LoadBlockMeta(height + 1)
+	if blockMeta == nil || nextBlockMeta == nil {
+		return state, fmt.Errorf("Blocks %d and %d must be in the block store", height, height+1)
+	}
+	nextHeader := nextBlockMeta.Header
+
+	lastValidators, err := loadValidatorsStepwise(db, height)
+	if err != nil {
+		return state, err
+	}
+	validators, err := loadValidatorsStepwise(db, height+1)
+	if err != nil {
+		return state, err
+	}
+	nextValidators, err := loadValidatorsStepwise(db, height+2)
+	if err != nil {
+		return state, err
+	}
+	consensusParams, err := LoadConsensusParams(db, height+1)
+	if err != nil {
+		return state, err
+	}
+	if !bytes.Equal(validators.Hash(), nextHeader.ValidatorsHash) ||
+		!bytes.Equal(nextValidators.Hash(), nextHeader.NextValidatorsHash) {
+		return state, fmt.Errorf("Validators of height %d don't match the block %d", height, height+1)
+	}
+
+	latestHeight := state.LastBlockHeight
+	rolledBack := State{
+		Version:                          Version{Consensus: nextHeader.Version, Software: state.Version.Software},
+		ChainID:                          state.ChainID,
+		LastBlockHeight:                  height,
+		LastBlockTotalTx:                 blockMeta.Header.TotalTxs,
+		LastBlockID:                      blockMeta.BlockID,
+		LastBlockTime:                    blockMeta.Header.Time,
+		NextValidators:                   nextValidators,
+		Validators:                       validators,
+		LastValidators:                   lastValidators,
+		LastHeightValidatorsChanged:      loadValidatorsInfo(db, height+2).LastHeightChanged,
+		ConsensusParams:                  consensusParams,
+		LastHeightConsensusParamsChanged: loadConsensusParamsInfo(db, height+1).LastHeightChanged,
+		LastResultsHash:                  nextHeader.LastResultsHash,
+		AppHash:                          nextHeader.AppHash,
+	}
+	SaveState(db, rolledBack)
+
+	batch := db.NewBatch()
+	for h := height + 1; h <= latestHeight; h++ {
+		batch.Delete(calcABCIResponsesKey(h))
+		batch.Delete(calcValidatorsKey(h + 2))
+		batch.Delete(calcConsensusParamsKey(h + 1))
+	}
+	batch.WriteSync()
+	return rolledBack, nil
+}
+
+// loadValidatorsStepwise is like LoadValidators, but increments the proposer
+// priorities of the last changed validator set once per height, as
+// updateState does, rather than all at once: the rolled back state must
+// select the same proposers as the rest of the network.
+func loadValidatorsStepwise(db dbm.DB, height int64) (*types.ValidatorSet, error) {
+	valInfo := loadValidatorsInfo(db, height)
+	if valInfo == nil {
+		return nil, ErrNoValSetForHeight{height}
+	}
+	if valInfo.ValidatorSet != nil {
+		return valInfo.ValidatorSet, nil
+	}
+	lastChanged := loadValidatorsInfo(db, valInfo.LastHeightChanged)
+	if lastChanged == nil || lastChanged.ValidatorSet == nil {
+		return nil, ErrNoValSetForHeight{valInfo.LastHeightChanged}
+	}
+	vals := lastChanged.ValidatorSet
+	for h := valInfo.LastHeightChanged; h < height; h++ {
+		vals.IncrementProposerPriority(1)
+	}
+	return vals, nil
+}
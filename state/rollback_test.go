@@ -0,0 +1,76 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// metaBlockStore only has the metas of its blocks.
+type metaBlockStore struct {
+	BlockStoreRPC
+	metas map[int64]*types.BlockMeta
+}
+
+func (bs metaBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	return bs.metas[height]
+}
+
+func TestRollbackState(t *testing.T) {
+	state, stateDB := state(2, 1)
+	bs := metaBlockStore{metas: make(map[int64]*types.BlockMeta)}
+
+	states := make(map[int64]State)
+	for h := int64(1); h <= 5; h++ {
+		block := makeBlock(state, h)
+		parts := block.MakePartSet(testPartSize)
+		blockID := types.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+		bs.metas[h] = types.NewBlockMeta(block, parts)
+
+		// change the validators at height 2, and the params at height 3
+		abciResponses := &ABCIResponses{EndBlock: &abci.ResponseEndBlock{}}
+		switch h {
+		case 2:
+			_, val := state.NextValidators.GetByIndex(0)
+			abciResponses.EndBlock.ValidatorUpdates = []abci.ValidatorUpdate{
+				types.TM2PB.NewValidatorUpdate(val.PubKey, 100),
+			}
+		case 3:
+			abciResponses.EndBlock.ConsensusParamUpdates = &abci.ConsensusParams{
+				BlockSize: &abci.BlockSizeParams{MaxBytes: 10000, MaxGas: 100},
+			}
+		}
+		validatorUpdates, err := types.PB2TM.ValidatorUpdates(abciResponses.EndBlock.ValidatorUpdates)
+		require.NoError(t, err)
+
+		state, err = updateState(state, blockID, &block.Header, abciResponses, validatorUpdates)
+		require.NoError(t, err)
+		state.AppHash = []byte{byte(h)}
+		saveABCIResponses(stateDB, h, abciResponses)
+		SaveState(stateDB, state)
+		states[h] = state
+	}
+
+	_, err := RollbackState(stateDB, bs, 5)
+	assert.Error(t, err, "the latest height")
+	delete(bs.metas, 4)
+	_, err = RollbackState(stateDB, bs, 3)
+	assert.Error(t, err, "missing block")
+	bs.metas[4] = types.NewBlockMeta(makeBlock(states[3], 4), makeBlock(states[3], 4).MakePartSet(testPartSize))
+
+	for _, height := range []int64{3, 1} {
+		rolledBack, err := RollbackState(stateDB, bs, height)
+		require.NoError(t, err)
+		assert.Equal(t, states[height].Bytes(), rolledBack.Bytes(), "height %d", height)
+		assert.Equal(t, states[height].Bytes(), LoadState(stateDB).Bytes(), "height %d", height)
+
+		_, err = LoadABCIResponses(stateDB, height)
+		assert.NoError(t, err)
+		_, err = LoadABCIResponses(stateDB, height+1)
+		assert.Error(t, err)
+	}
+}
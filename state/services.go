@@ -24,6 +24,8 @@ type Mempool interface {
 	Size() int
 	CheckTx(types.Tx, func(*abci.Response)) error
 	ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs
+	ReapMaxBytesMaxGasOrdered(maxBytes, maxGas int64) types.Txs
+	SetTxPriority(tx types.Tx, priority int64)
 	Update(int64, types.Txs, mempool.PreCheckFunc, mempool.PostCheckFunc) error
 	Flush()
 	FlushAppConn() error
@@ -42,6 +44,8 @@ func (MockMempool) Unlock()                                          {}
 func (MockMempool) Size() int                                        { return 0 }
 func (MockMempool) CheckTx(_ types.Tx, _ func(*abci.Response)) error { return nil }
 func (MockMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs          { return types.Txs{} }
+func (MockMempool) ReapMaxBytesMaxGasOrdered(_, _ int64) types.Txs   { return types.Txs{} }
+func (MockMempool) SetTxPriority(_ types.Tx, _ int64)                {}
 func (MockMempool) Update(
 	_ int64,
 	_ types.Txs,
@@ -76,6 +80,12 @@ type BlockStore interface {
 	SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
 }
 
+// PrunableBlockStore defines the BlockStore interface used by the Pruner.
+type PrunableBlockStore interface {
+	BlockStore
+	DeleteBlock(height int64) error
+}
+
 //-----------------------------------------------------------------------------------------------------
 // evidence pool
 
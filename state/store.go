@@ -1,6 +1,7 @@
 package state
 
 import (
+	"errors"
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -101,6 +102,40 @@ func saveState(db dbm.DB, state State, key []byte) {
 	db.SetSync(key, state.Bytes())
 }
 
+// ExportState calls fn with the keys and values of the records of db that
+// make up state, loaded from db: the validators, consensus params and ABCI
+// responses of every height up to it, and the state itself, last. Writing
+// them to an empty DB restores the state. As these records are never changed
+// once saved, state can be exported while newer states are saved.
+func ExportState(db dbm.DB, state State, fn func(key, value []byte) error) error {
+	if state.IsEmpty() {
+		return errors.New("No state to export")
+	}
+
+	// see saveState for the heights saved with the state
+	height := state.LastBlockHeight
+	for h := int64(1); h <= height+2; h++ {
+		keys := [][]byte{calcValidatorsKey(h)}
+		if h <= height+1 {
+			keys = append(keys, calcConsensusParamsKey(h))
+		}
+		if h <= height {
+			keys = append(keys, calcABCIResponsesKey(h))
+		}
+		for _, key := range keys {
+			value := db.Get(key)
+			if value == nil {
+				continue
+			}
+			if err := fn(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	// the state may have been saved again since it was loaded
+	return fn(stateKey, state.Bytes())
+}
+
 //------------------------------------------------------------------------
 
 // ABCIResponses retains the responses
@@ -162,6 +197,12 @@ func saveABCIResponses(db dbm.DB, height int64, abciResponses *ABCIResponses) {
 	db.SetSync(calcABCIResponsesKey(height), abciResponses.Bytes())
 }
 
+// DeleteABCIResponses deletes the ABCIResponses for the given height from the
+// database, e.g. when its block is pruned.
+func DeleteABCIResponses(db dbm.DB, height int64) {
+	db.DeleteSync(calcABCIResponsesKey(height))
+}
+
 //-----------------------------------------------------------------------------
 
 // ValidatorsInfo represents the latest validator set, or the last height it changed
@@ -64,6 +64,11 @@ func (b *EventBus) UnsubscribeAll(ctx context.Context, subscriber string) error
 	return b.pubsub.UnsubscribeAll(ctx, subscriber)
 }
 
+// NumClientSubscriptions returns the number of subscriptions of subscriber.
+func (b *EventBus) NumClientSubscriptions(subscriber string) int {
+	return b.pubsub.NumClientSubscriptions(subscriber)
+}
+
 func (b *EventBus) Publish(eventType string, eventData TMEventData) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
@@ -95,6 +100,11 @@ func (b *EventBus) PublishEventNewBlock(data EventDataNewBlock) error {
 	logIfTagExists(EventTypeKey, tags, b.Logger)
 	tags[EventTypeKey] = EventNewBlock
 
+	if data.Block != nil {
+		logIfTagExists(BlockHeightKey, tags, b.Logger)
+		tags[BlockHeightKey] = fmt.Sprintf("%d", data.Block.Height)
+	}
+
 	b.pubsub.PublishWithTags(ctx, data, tmpubsub.NewTagMap(tags))
 	return nil
 }
@@ -111,6 +121,9 @@ func (b *EventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) erro
 	logIfTagExists(EventTypeKey, tags, b.Logger)
 	tags[EventTypeKey] = EventNewBlockHeader
 
+	logIfTagExists(BlockHeightKey, tags, b.Logger)
+	tags[BlockHeightKey] = fmt.Sprintf("%d", data.Header.Height)
+
 	b.pubsub.PublishWithTags(ctx, data, tmpubsub.NewTagMap(tags))
 	return nil
 }
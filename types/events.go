@@ -127,6 +127,9 @@ const (
 	// TxHeightKey is a reserved key, used to specify transaction block's height.
 	// see EventBus#PublishEventTx
 	TxHeightKey = "tx.height"
+	// BlockHeightKey is a reserved key, used to specify the height of a block.
+	// see EventBus#PublishEventNewBlock and EventBus#PublishEventNewBlockHeader
+	BlockHeightKey = "block.height"
 )
 
 var (